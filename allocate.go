@@ -0,0 +1,125 @@
+package i18n
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+)
+
+// ErrMoneyInvalidRatios is returned when allocating by ratios that are
+// empty, negative or all zero.
+var ErrMoneyInvalidRatios = errors.New("i18n: invalid allocation ratios")
+
+// RemainderStrategy specifies which parts of an allocation receive the
+// minor units that are left over after dividing by the ratios.
+type RemainderStrategy int
+
+const (
+	// RemainderLargestFraction gives the left over units to the parts whose
+	// exact share has the largest fractional part (the largest remainder
+	// method). Ties go to the earlier parts.
+	RemainderLargestFraction RemainderStrategy = iota
+	// RemainderFirst gives the left over units to the first parts, like Split.
+	RemainderFirst
+	// RemainderLast gives the left over units to the last parts.
+	RemainderLast
+)
+
+// Allocate distributes m by the given ratios, e.g. 70, 20 and 10 for a
+// revenue share, using RemainderLargestFraction. The parts always add up to
+// m, and a part with a ratio of zero is always zero.
+// Examples:
+// Money{C:"CAD",M:100}.Allocate(70, 20, 10) -> {70,20,10}
+// Money{C:"CAD",M:5}.Allocate(1, 1, 1) -> {2,2,1}
+// It panics if there are no ratios, or if they are negative or all zero.
+func (m Money) Allocate(ratios ...int64) []Money {
+	result, err := m.AllocateChecked(RemainderLargestFraction, ratios...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// AllocateChecked is like Allocate, but uses the given strategy and returns
+// an error instead of panicking.
+func (m Money) AllocateChecked(strategy RemainderStrategy, ratios ...int64) ([]Money, error) {
+	rats := make([]*big.Rat, len(ratios))
+	for i, ratio := range ratios {
+		rats[i] = new(big.Rat).SetInt64(ratio)
+	}
+	return m.AllocateRat(strategy, rats...)
+}
+
+// AllocateRat is like AllocateChecked, but takes the ratios as *big.Rat,
+// e.g. the days of a pro-rata refund as a fraction of the billing period.
+func (m Money) AllocateRat(strategy RemainderStrategy, ratios ...*big.Rat) ([]Money, error) {
+	parts, err := allocate(big.NewInt(m.M), strategy, ratios)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Money, len(parts))
+	for i, part := range parts {
+		result[i] = Money{M: part.Int64(), C: m.C}
+	}
+	return result, nil
+}
+
+// allocate distributes amount by ratios (see Money.AllocateRat).
+func allocate(amount *big.Int, strategy RemainderStrategy, ratios []*big.Rat) ([]*big.Int, error) {
+	total := new(big.Rat)
+	for _, ratio := range ratios {
+		if ratio.Sign() < 0 {
+			return nil, ErrMoneyInvalidRatios
+		}
+		total.Add(total, ratio)
+	}
+	if total.Sign() == 0 {
+		return nil, ErrMoneyInvalidRatios
+	}
+
+	// Allocate the absolute value, so that the parts are rounded towards
+	// zero and the left over units have the sign of amount.
+	abs := new(big.Int).Abs(amount)
+	parts := make([]*big.Int, len(ratios))
+	fractions := make([]*big.Rat, len(ratios))
+	left := new(big.Int).Set(abs)
+	for i, ratio := range ratios {
+		share := new(big.Rat).SetInt(abs)
+		share.Mul(share, ratio).Quo(share, total)
+		parts[i], fractions[i] = new(big.Int), new(big.Rat)
+		rem := new(big.Int)
+		parts[i].QuoRem(share.Num(), share.Denom(), rem)
+		fractions[i].SetFrac(rem, share.Denom())
+		left.Sub(left, parts[i])
+	}
+
+	// Only parts with a share receive left over units. There are always more
+	// of them than left over units, as the fractions add up to the latter.
+	var order []int
+	for i, ratio := range ratios {
+		if ratio.Sign() > 0 {
+			order = append(order, i)
+		}
+	}
+	switch strategy {
+	case RemainderLargestFraction:
+		sort.SliceStable(order, func(i, j int) bool {
+			return fractions[order[i]].Cmp(fractions[order[j]]) > 0
+		})
+	case RemainderLast:
+		for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+			order[i], order[j] = order[j], order[i]
+		}
+	}
+	one := big.NewInt(1)
+	for i := int64(0); i < left.Int64(); i++ {
+		parts[order[i]].Add(parts[order[i]], one)
+	}
+
+	if amount.Sign() < 0 {
+		for _, part := range parts {
+			part.Neg(part)
+		}
+	}
+	return parts, nil
+}
@@ -0,0 +1,89 @@
+package i18n
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		m        Money
+		strategy RemainderStrategy
+		ratios   []int64
+		expected []int64
+	}{
+		{Money{M: 100, C: CAD}, RemainderLargestFraction, []int64{70, 20, 10}, []int64{70, 20, 10}},
+		{Money{M: 5, C: CAD}, RemainderLargestFraction, []int64{1, 1, 1}, []int64{2, 2, 1}},
+		{Money{M: 5, C: CAD}, RemainderFirst, []int64{1, 1, 1}, []int64{2, 2, 1}},
+		{Money{M: 5, C: CAD}, RemainderLast, []int64{1, 1, 1}, []int64{1, 2, 2}},
+		{Money{M: 100, C: CAD}, RemainderLargestFraction, []int64{1, 2, 4}, []int64{14, 29, 57}},
+		{Money{M: 100, C: CAD}, RemainderFirst, []int64{1, 2, 4}, []int64{15, 28, 57}},
+		{Money{M: 100, C: CAD}, RemainderLast, []int64{1, 2, 4}, []int64{14, 28, 58}},
+		{Money{M: -100, C: CAD}, RemainderLargestFraction, []int64{1, 2, 4}, []int64{-14, -29, -57}},
+		{Money{M: 10, C: CAD}, RemainderFirst, []int64{0, 1, 0, 1, 1}, []int64{0, 4, 0, 3, 3}},
+		{Money{M: 10, C: CAD}, RemainderLast, []int64{1, 1, 1, 0}, []int64{3, 3, 4, 0}},
+		{Money{M: 0, C: CAD}, RemainderLargestFraction, []int64{1, 1}, []int64{0, 0}},
+		{Money{M: 7, C: CAD}, RemainderLargestFraction, []int64{3}, []int64{7}},
+		{Money{M: math.MinInt64, C: CAD}, RemainderLargestFraction, []int64{1, 0}, []int64{math.MinInt64, 0}},
+		{Money{M: math.MaxInt64, C: CAD}, RemainderLargestFraction, []int64{math.MaxInt64, math.MaxInt64}, []int64{4611686018427387904, 4611686018427387903}},
+	}
+	for _, test := range tests {
+		got, err := test.m.AllocateChecked(test.strategy, test.ratios...)
+		if err != nil {
+			t.Errorf("%v %v: unexpected error: %v", test.m, test.ratios, err)
+			continue
+		}
+		var values []int64
+		var sum int64
+		for _, part := range got {
+			if part.C != test.m.C {
+				t.Errorf("%v %v: expected currency %s, got %s", test.m, test.ratios, test.m.C, part.C)
+			}
+			values = append(values, part.M)
+			sum += part.M
+		}
+		if !reflect.DeepEqual(values, test.expected) {
+			t.Errorf("%v %v (strategy %d): expected %v, got %v", test.m, test.ratios, test.strategy, test.expected, values)
+		}
+		if sum != test.m.M {
+			t.Errorf("%v %v: parts add up to %d", test.m, test.ratios, sum)
+		}
+	}
+}
+
+func TestAllocateDefault(t *testing.T) {
+	got := Money{M: 1000, C: EUR}.Allocate(1, 1, 1)
+	expected := []Money{{M: 334, C: EUR}, {M: 333, C: EUR}, {M: 333, C: EUR}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestAllocateRat(t *testing.T) {
+	// Refund 10 of 31 days
+	got, err := Money{M: 2999, C: USD}.AllocateRat(RemainderLargestFraction, big.NewRat(10, 31), big.NewRat(21, 31))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []Money{{M: 967, C: USD}, {M: 2032, C: USD}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestAllocateInvalidRatios(t *testing.T) {
+	for _, ratios := range [][]int64{nil, {0, 0}, {1, -1}} {
+		if _, err := (Money{M: 100, C: USD}).AllocateChecked(RemainderFirst, ratios...); !errors.Is(err, ErrMoneyInvalidRatios) {
+			t.Errorf("%v: expected ErrMoneyInvalidRatios, got %v", ratios, err)
+		}
+	}
+	defer func() {
+		if r := recover(); r != ErrMoneyInvalidRatios {
+			t.Errorf("expected panic with ErrMoneyInvalidRatios, got %v", r)
+		}
+	}()
+	Money{M: 100, C: USD}.Allocate()
+}
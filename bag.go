@@ -0,0 +1,249 @@
+package i18n
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// MoneyBag holds amounts in several currencies, e.g. the balances of a
+// multi-currency wallet. It keeps one Money per currency, and leaves out
+// currencies whose amount is zero. The zero value is an empty bag.
+type MoneyBag struct {
+	amounts map[CurrencyCode]int64
+}
+
+// NewMoneyBag returns a bag holding the sum of the given values.
+func NewMoneyBag(values ...Money) (*MoneyBag, error) {
+	b := &MoneyBag{}
+	for _, v := range values {
+		if err := b.AddChecked(v); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Add adds m to the amount of its currency in b.
+// It panics if the amount overflows or if m has no known currency.
+func (b *MoneyBag) Add(m Money) {
+	if err := b.AddChecked(m); err != nil {
+		panic(err)
+	}
+}
+
+// AddChecked is like Add, but returns an error instead of panicking.
+func (b *MoneyBag) AddChecked(m Money) error {
+	if err := m.C.validate(); err != nil {
+		return err
+	}
+	sum, err := b.Get(m.C).add(m)
+	if err != nil {
+		return err
+	}
+	b.set(sum)
+	return nil
+}
+
+// Sub subtracts m from the amount of its currency in b.
+// It panics if the amount overflows or if m has no known currency.
+func (b *MoneyBag) Sub(m Money) {
+	if err := b.SubChecked(m); err != nil {
+		panic(err)
+	}
+}
+
+// SubChecked is like Sub, but returns an error instead of panicking.
+func (b *MoneyBag) SubChecked(m Money) error {
+	if err := m.C.validate(); err != nil {
+		return err
+	}
+	diff, err := b.Get(m.C).sub(m)
+	if err != nil {
+		return err
+	}
+	b.set(diff)
+	return nil
+}
+
+func (b *MoneyBag) set(m Money) {
+	if m.M == 0 {
+		delete(b.amounts, m.C)
+		return
+	}
+	if b.amounts == nil {
+		b.amounts = make(map[CurrencyCode]int64)
+	}
+	b.amounts[m.C] = m.M
+}
+
+// Get returns the amount of currency c in b, which is zero if b holds none.
+func (b *MoneyBag) Get(c CurrencyCode) Money {
+	return Money{M: b.amounts[c], C: c}
+}
+
+// Len returns the number of currencies in b.
+func (b *MoneyBag) Len() int {
+	return len(b.amounts)
+}
+
+// IsZero reports whether b holds no amounts.
+func (b *MoneyBag) IsZero() bool {
+	return len(b.amounts) == 0
+}
+
+// Currencies returns the currencies in b, sorted by code.
+func (b *MoneyBag) Currencies() []CurrencyCode {
+	codes := make([]CurrencyCode, 0, len(b.amounts))
+	for c := range b.amounts {
+		codes = append(codes, c)
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+	return codes
+}
+
+// Values returns the amounts in b, sorted by currency.
+func (b *MoneyBag) Values() []Money {
+	var values []Money
+	for _, c := range b.Currencies() {
+		values = append(values, b.Get(c))
+	}
+	return values
+}
+
+// String returns the amounts in b like Money.String, sorted by currency and
+// separated by commas, e.g. "12.00 EUR, 5.00 USD".
+func (b *MoneyBag) String() string {
+	var parts []string
+	for _, v := range b.Values() {
+		parts = append(parts, v.String())
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Format formats each amount in b for the given locale with Money.Format,
+// sorted by currency.
+func (b *MoneyBag) Format(locale string) []string {
+	var parts []string
+	for _, v := range b.Values() {
+		parts = append(parts, v.Format(locale))
+	}
+	return parts
+}
+
+// Collapse returns the sum of all amounts in b, converted into currency c
+// with the given rates. The sum is computed exactly and rounded once with
+// RoundHalfCeiling. It returns ErrMoneyNoRateSource if an amount needs to
+// be converted and rates is nil.
+func (b *MoneyBag) Collapse(c CurrencyCode, rates RateSource) (Money, error) {
+	result := Money{C: c}
+	sum := new(big.Rat)
+	for _, v := range b.Values() {
+		r := new(big.Rat).SetFrac64(v.M, v.dp())
+		if v.C != c {
+			if rates == nil {
+				return Money{}, ErrMoneyNoRateSource
+			}
+			rate, err := rates.Rate(v.C, c)
+			if err != nil {
+				return Money{}, err
+			}
+			r.Mul(r, rate)
+		}
+		sum.Add(sum, r)
+	}
+	sum.Mul(sum, new(big.Rat).SetInt64(result.dp()))
+	v, err := RoundHalfCeiling.roundRat(sum)
+	if err != nil {
+		return Money{}, err
+	}
+	result.M = v
+	return result, nil
+}
+
+// MarshalJSON implements json.Marshaler. The bag is encoded as an array of
+// its amounts, sorted by currency, each encoded like Money.MarshalJSON.
+// Use JSON for the other modes.
+func (b MoneyBag) MarshalJSON() ([]byte, error) {
+	values := b.Values()
+	if values == nil {
+		values = []Money{}
+	}
+	return json.Marshal(values)
+}
+
+// JSON returns the amounts of b, sorted by currency, to be encoded in the
+// given mode, e.g. json.Marshal(b.JSON(JSONDecimal)).
+func (b *MoneyBag) JSON(mode JSONMode) []MoneyJSON {
+	values := make([]MoneyJSON, 0, len(b.amounts))
+	for _, v := range b.Values() {
+		values = append(values, MoneyJSON{Money: v, Mode: mode})
+	}
+	return values
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts an array of
+// amounts, as decoded by Money.UnmarshalJSON. Amounts in the same currency
+// are added up.
+func (b *MoneyBag) UnmarshalJSON(data []byte) error {
+	var values []Money
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	return b.reset(values)
+}
+
+// MarshalText implements encoding.TextMarshaler. The text form is the
+// canonical text form of each amount, sorted by currency and separated by
+// commas, e.g. "12.00 EUR, 5.00 USD".
+func (b MoneyBag) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the form
+// of MarshalText. Amounts in the same currency are added up.
+func (b *MoneyBag) UnmarshalText(text []byte) error {
+	var values []Money
+	for _, s := range strings.Split(string(text), ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		v, err := parseMoneyString(s)
+		if err != nil {
+			return err
+		}
+		values = append(values, v)
+	}
+	return b.reset(values)
+}
+
+// Value implements driver.Valuer. It stores b in its text form (see
+// MarshalText).
+func (b MoneyBag) Value() (driver.Value, error) {
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner. It accepts strings and byte slices in the
+// text form of MarshalText. NULL is an empty bag.
+func (b *MoneyBag) Scan(src interface{}) error {
+	if src == nil {
+		return b.reset(nil)
+	}
+	s, err := scanString(src, "MoneyBag")
+	if err != nil {
+		return err
+	}
+	return b.UnmarshalText([]byte(s))
+}
+
+// reset replaces the amounts of b with the sum of values.
+func (b *MoneyBag) reset(values []Money) error {
+	v, err := NewMoneyBag(values...)
+	if err != nil {
+		return err
+	}
+	*b = *v
+	return nil
+}
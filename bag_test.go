@@ -0,0 +1,123 @@
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestMoneyBag(t *testing.T) {
+	var b MoneyBag
+	if !b.IsZero() || b.Len() != 0 || b.String() != "" {
+		t.Errorf("expected empty bag, got %v", &b)
+	}
+	b.Add(Money{M: 500, C: USD})
+	b.Add(Money{M: 1200, C: EUR})
+	b.Add(Money{M: 1234, C: JPY})
+	b.Sub(Money{M: 200, C: USD})
+	b.Sub(Money{M: 100, C: CHF})
+
+	expected := []Money{{M: -100, C: CHF}, {M: 1200, C: EUR}, {M: 1234, C: JPY}, {M: 300, C: USD}}
+	if got := b.Values(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if got := b.Currencies(); !reflect.DeepEqual(got, []CurrencyCode{CHF, EUR, JPY, USD}) {
+		t.Errorf("unexpected currencies %v", got)
+	}
+	if got := b.String(); got != "-1.00 CHF, 12.00 EUR, 1234 JPY, 3.00 USD" {
+		t.Errorf("unexpected String %q", got)
+	}
+	if got := b.Get(GBP); got != (Money{C: GBP}) {
+		t.Errorf("expected zero GBP, got %v", got)
+	}
+
+	b.Add(Money{M: 100, C: CHF})
+	if b.Len() != 3 {
+		t.Errorf("expected zero amounts to be removed, got %v", &b)
+	}
+	if got := b.Format("de_DE"); !reflect.DeepEqual(got, []string{"12,00 €", "1.234 ¥", "3,00 $"}) {
+		t.Errorf("unexpected Format %q", got)
+	}
+
+	if err := b.AddChecked(Money{M: math.MaxInt64, C: USD}); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("expected ErrMoneyOverflow, got %v", err)
+	}
+	if err := b.AddChecked(Money{M: 1}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+	if got := b.Get(USD); got != (Money{M: 300, C: USD}) {
+		t.Errorf("expected failed operations to leave the bag unchanged, got %v", got)
+	}
+}
+
+func TestMoneyBagCollapse(t *testing.T) {
+	b, err := NewMoneyBag(Money{M: 1000, C: USD}, Money{M: 1000, C: EUR}, Money{M: 1000, C: JPY})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rates := testRates{
+		EUR: {USD: big.NewRat(1085, 1000)},
+		JPY: {USD: big.NewRat(1, 150)},
+	}
+	got, err := b.Collapse(USD, rates)
+	if err != nil || got != (Money{M: 2752, C: USD}) {
+		t.Errorf("expected 27.52 USD, got %v, %v", got, err)
+	}
+	if _, err := b.Collapse(GBP, rates); err == nil {
+		t.Errorf("expected error for missing rate")
+	}
+
+	if _, err := b.Collapse(USD, nil); !errors.Is(err, ErrMoneyNoRateSource) {
+		t.Errorf("expected ErrMoneyNoRateSource, got %v", err)
+	}
+	single, _ := NewMoneyBag(Money{M: 5, C: EUR})
+	if got, err := single.Collapse(EUR, nil); err != nil || got != (Money{M: 5, C: EUR}) {
+		t.Errorf("expected 0.05 EUR, got %v, %v", got, err)
+	}
+}
+
+func TestMoneyBagEncoding(t *testing.T) {
+	b, _ := NewMoneyBag(Money{M: 500, C: USD}, Money{M: -1200, C: EUR})
+
+	data, err := json.Marshal(b)
+	expected := `[{"M":-1200,"C":"EUR","F":-12},{"M":500,"C":"USD","F":5}]`
+	if err != nil || string(data) != expected {
+		t.Errorf("expected %s, got %s, %v", expected, data, err)
+	}
+	data, err = json.Marshal(b.JSON(JSONDecimal))
+	expected = `[{"amount":"-12.00","currency":"EUR"},{"amount":"5.00","currency":"USD"}]`
+	if err != nil || string(data) != expected {
+		t.Errorf("expected %s, got %s, %v", expected, data, err)
+	}
+	if data, _ := json.Marshal((&MoneyBag{}).JSON(JSONDecimal)); string(data) != "[]" {
+		t.Errorf("expected [], got %s", data)
+	}
+	var got MoneyBag
+	if err := json.Unmarshal([]byte(`[{"M":100,"C":"USD"},{"amount":"4.00","currency":"USD"},{"M":-1200,"C":"EUR"}]`), &got); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Values(), b.Values()) {
+		t.Errorf("expected %v, got %v", b, &got)
+	}
+	if data, _ := json.Marshal(MoneyBag{}); string(data) != "[]" {
+		t.Errorf("expected [], got %s", data)
+	}
+
+	v, err := b.Value()
+	if err != nil || v != "-12.00 EUR, 5.00 USD" {
+		t.Errorf("unexpected Value %v, %v", v, err)
+	}
+	got = MoneyBag{}
+	if err := got.Scan([]byte("-12.00 EUR, 5.00 USD")); err != nil || !reflect.DeepEqual(got.Values(), b.Values()) {
+		t.Errorf("expected %v, got %v, %v", b, &got, err)
+	}
+	if err := got.Scan(nil); err != nil || !got.IsZero() {
+		t.Errorf("expected empty bag, got %v, %v", &got, err)
+	}
+	if err := got.Scan("5 dollars"); err == nil {
+		t.Errorf("expected error")
+	}
+}
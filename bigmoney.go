@@ -0,0 +1,334 @@
+package i18n
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// A BigMoney represents an amount of money in a specific currency, like
+// Money, but with arbitrary-precision minor units. It is meant for amounts
+// that don't fit into the int64 of a Money, e.g. in currencies of
+// hyperinflation like VEF or ZWL, or for ledgers of large totals.
+//
+// A nil M is zero. A BigMoney value is immutable: operations return new
+// values and never modify M, so M must not be modified after the value is
+// created.
+type BigMoney struct {
+	M *big.Int
+	C CurrencyCode
+}
+
+// NewBigMoney returns minor units of currency c, e.g. 123456 for 1234.56 USD.
+// It copies minor.
+func NewBigMoney(c CurrencyCode, minor *big.Int) BigMoney {
+	return BigMoney{M: new(big.Int).Set(minor), C: c}
+}
+
+// ParseBigMoney parses amount, a decimal number like "-1234.56", as an
+// amount of currency c. Fewer decimals than the currency has are accepted.
+// Errors are of type *ParseError.
+func ParseBigMoney(c CurrencyCode, amount string) (BigMoney, error) {
+	if err := c.validate(); err != nil {
+		return BigMoney{}, &ParseError{Input: amount, Offset: 0, Reason: fmt.Sprintf("unknown currency %q", c), Err: err}
+	}
+	v, err := parseBigDecimal(amount, c, amount)
+	if err != nil {
+		return BigMoney{}, err
+	}
+	return BigMoney{M: v, C: c}, nil
+}
+
+// Big converts m to a BigMoney.
+func (m Money) Big() BigMoney {
+	return BigMoney{M: big.NewInt(m.M), C: m.C}
+}
+
+// Money converts m to a Money. It returns ErrMoneyOverflow if the minor
+// units of m don't fit into an int64.
+func (m BigMoney) Money() (Money, error) {
+	v := m.int()
+	if !v.IsInt64() {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{M: v.Int64(), C: m.C}, nil
+}
+
+// int returns the minor units of m, which are never nil.
+func (m BigMoney) int() *big.Int {
+	if m.M == nil {
+		return new(big.Int)
+	}
+	return m.M
+}
+
+// rat returns the amount of m in major units, e.g. 1234.56 for 1234.56 USD.
+func (m BigMoney) rat() *big.Rat {
+	return new(big.Rat).SetFrac(m.int(), big.NewInt(Money{C: m.C}.dp()))
+}
+
+// Abs returns the absolute value of m.
+func (m BigMoney) Abs() BigMoney {
+	return BigMoney{M: new(big.Int).Abs(m.int()), C: m.C}
+}
+
+// Neg returns the negative value of m.
+func (m BigMoney) Neg() BigMoney {
+	return BigMoney{M: new(big.Int).Neg(m.int()), C: m.C}
+}
+
+// Sign returns the sign of m: -1 if negative, 0 if zero, and 1 if positive.
+func (m BigMoney) Sign() int {
+	return m.int().Sign()
+}
+
+// IsZero reports whether m is zero.
+func (m BigMoney) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Cmp compares m and n and returns -1, 0 or 1 like Money.Cmp. It returns
+// a *CurrencyMismatchError if the currencies differ; an empty currency is
+// compatible with any other currency.
+func (m BigMoney) Cmp(n BigMoney) (int, error) {
+	if m.C != "" && n.C != "" && m.C != n.C {
+		return 0, &CurrencyMismatchError{A: m.C, B: n.C}
+	}
+	return m.int().Cmp(n.int()), nil
+}
+
+// Add returns the sum of m and n.
+// It panics if the currencies of m and n differ.
+func (m BigMoney) Add(n BigMoney) BigMoney {
+	r, err := m.AddChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// AddChecked is like Add, but returns an error instead of panicking.
+// Operands of different currencies are handled like in Money.AddChecked.
+func (m BigMoney) AddChecked(n BigMoney) (BigMoney, error) {
+	return MoneyMath{}.AddBig(m, n)
+}
+
+// Sub returns the result of subtracting n from m.
+// It panics if the currencies of m and n differ.
+func (m BigMoney) Sub(n BigMoney) BigMoney {
+	r, err := m.SubChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SubChecked is like Sub, but returns an error instead of panicking.
+// Operands of different currencies are handled like in Money.AddChecked.
+func (m BigMoney) SubChecked(n BigMoney) (BigMoney, error) {
+	return MoneyMath{}.SubBig(m, n)
+}
+
+// AddBig is like Add, but for BigMoney.
+func (a MoneyMath) AddBig(m, n BigMoney) (BigMoney, error) {
+	n, err := a.bigOperand(m, n)
+	if err != nil {
+		return BigMoney{}, err
+	}
+	if m.C == "" {
+		m.C = n.C
+	}
+	return BigMoney{M: new(big.Int).Add(m.int(), n.int()), C: m.C}, nil
+}
+
+// SubBig is like Sub, but for BigMoney.
+func (a MoneyMath) SubBig(m, n BigMoney) (BigMoney, error) {
+	n, err := a.bigOperand(m, n)
+	if err != nil {
+		return BigMoney{}, err
+	}
+	if m.C == "" {
+		m.C = n.C
+	}
+	return BigMoney{M: new(big.Int).Sub(m.int(), n.int()), C: m.C}, nil
+}
+
+// bigOperand returns n in a form that can be combined with m, according
+// to a.Policy (see MoneyMath.operand).
+func (a MoneyMath) bigOperand(m, n BigMoney) (BigMoney, error) {
+	rate, err := a.rate(m.C, n.C)
+	if err != nil || rate == nil {
+		return n, err
+	}
+	return n.convert(m.C, rate, RoundHalfCeiling), nil
+}
+
+// convert returns m converted into currency c with rate, rounded to the
+// minor unit of c with mode (see Money.convert).
+func (m BigMoney) convert(c CurrencyCode, rate *big.Rat, mode RoundingMode) BigMoney {
+	r := new(big.Rat).SetInt(m.int())
+	r.Mul(r, rate)
+	r.Mul(r, new(big.Rat).SetFrac64(Money{C: c}.dp(), Money{C: m.C}.dp()))
+	return BigMoney{M: mode.round(r.Num(), r.Denom()), C: c}
+}
+
+// Convert returns m converted into currency to with a rate from rates,
+// rounded to the minor unit of to with RoundHalfCeiling, like Money.Convert.
+func (m BigMoney) Convert(to CurrencyCode, rates RateSource) (BigMoney, error) {
+	return m.ConvertRounded(to, rates, RoundHalfCeiling)
+}
+
+// ConvertRounded is like Convert, but rounds with the given mode.
+func (m BigMoney) ConvertRounded(to CurrencyCode, rates RateSource, mode RoundingMode) (BigMoney, error) {
+	if m.C == to {
+		return m, nil
+	}
+	if rates == nil {
+		return BigMoney{}, ErrMoneyNoRateSource
+	}
+	rate, err := rates.Rate(m.C, to)
+	if err != nil {
+		return BigMoney{}, err
+	}
+	return m.convert(to, rate, mode), nil
+}
+
+// MulRat returns the result of multiplying m by r, rounded to the minor
+// unit of the currency with RoundHalfCeiling. The multiplication is exact.
+func (m BigMoney) MulRat(r *big.Rat) BigMoney {
+	return m.MulRatRounded(r, RoundHalfCeiling)
+}
+
+// MulRatRounded is like MulRat, but rounds the result with the given mode.
+func (m BigMoney) MulRatRounded(r *big.Rat, mode RoundingMode) BigMoney {
+	res := new(big.Rat).SetInt(m.int())
+	res.Mul(res, r)
+	return BigMoney{M: mode.round(res.Num(), res.Denom()), C: m.C}
+}
+
+// Split splits m into chunks parts like Money.Split, with the earlier
+// parts getting the larger amounts.
+// It panics if chunks is zero or less.
+func (m BigMoney) Split(chunks int64) []BigMoney {
+	result, err := m.SplitChecked(chunks)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// SplitChecked is like Split, but returns an error instead of panicking.
+func (m BigMoney) SplitChecked(chunks int64) ([]BigMoney, error) {
+	if chunks <= 0 {
+		return nil, ErrMoneyZeroOrLessChunks
+	}
+	ratios := make([]*big.Rat, chunks)
+	for i := range ratios {
+		ratios[i] = big.NewRat(1, 1)
+	}
+	return m.AllocateRat(RemainderFirst, ratios...)
+}
+
+// Allocate distributes m by the given ratios like Money.Allocate.
+// It panics if there are no ratios, or if they are negative or all zero.
+func (m BigMoney) Allocate(ratios ...int64) []BigMoney {
+	rats := make([]*big.Rat, len(ratios))
+	for i, ratio := range ratios {
+		rats[i] = new(big.Rat).SetInt64(ratio)
+	}
+	result, err := m.AllocateRat(RemainderLargestFraction, rats...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// AllocateRat is like Money.AllocateRat.
+func (m BigMoney) AllocateRat(strategy RemainderStrategy, ratios ...*big.Rat) ([]BigMoney, error) {
+	parts, err := allocate(m.int(), strategy, ratios)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]BigMoney, len(parts))
+	for i, part := range parts {
+		result[i] = BigMoney{M: part, C: m.C}
+	}
+	return result, nil
+}
+
+// String returns the canonical text form of m like Money.String,
+// e.g. "1234.56 USD".
+func (m BigMoney) String() string {
+	return decimalString(m.int(), Money{C: m.C}.digits()) + " " + string(m.C)
+}
+
+// Format formats m for the given locale like Money.Format.
+func (m BigMoney) Format(locale string) string {
+	return m.FormatWith(locale, FormatOptions{})
+}
+
+// FormatWith formats m for the given locale and options like
+// Money.FormatWith.
+func (m BigMoney) FormatWith(locale string, opts FormatOptions) string {
+	l, found := Locales[locale]
+	if !found {
+		return m.String()
+	}
+	return formatMoney(l, m.C, m.int(), Money{C: m.C}.digits(), opts)
+}
+
+// MarshalJSON implements json.Marshaler, using JSONLegacy like
+// Money.MarshalJSON. F is the nearest float64 to the amount. Use
+// BigMoneyJSON for the other modes.
+func (m BigMoney) MarshalJSON() ([]byte, error) {
+	return BigMoneyJSON{BigMoney: m}.MarshalJSON()
+}
+
+// BigMoneyJSON is like MoneyJSON, but for BigMoney.
+type BigMoneyJSON struct {
+	BigMoney
+	Mode JSONMode
+}
+
+// MarshalJSON implements json.Marshaler, using m.Mode.
+func (m BigMoneyJSON) MarshalJSON() ([]byte, error) {
+	f, _ := m.rat().Float64()
+	return marshalMoneyJSON(m.int(), m.C, f, m.Mode)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the same shapes as
+// Money.UnmarshalJSON, without limits on the amount, and also leaves m
+// unchanged for null.
+func (m *BigMoney) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	v, err := unmarshalMoneyJSON(b)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler like Money.MarshalText.
+func (m BigMoney) MarshalText() ([]byte, error) {
+	if m.C == "" && m.IsZero() {
+		return nil, nil
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler like
+// Money.UnmarshalText.
+func (m *BigMoney) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*m = BigMoney{}
+		return nil
+	}
+	v, err := parseBigMoneyString(string(b))
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
@@ -0,0 +1,220 @@
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func bigMoney(t *testing.T, c CurrencyCode, amount string) BigMoney {
+	t.Helper()
+	m, err := ParseBigMoney(c, amount)
+	if err != nil {
+		t.Fatalf("%s %s: %v", amount, c, err)
+	}
+	return m
+}
+
+func TestBigMoneyConversions(t *testing.T) {
+	for _, m := range []Money{{0, ""}, {123456, USD}, {math.MaxInt64, VEF}, {math.MinInt64, IRR}} {
+		got, err := m.Big().Money()
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", m, err)
+		} else if got != m {
+			t.Errorf("expected %v, got %v", m, got)
+		}
+	}
+
+	over := Money{M: math.MaxInt64, C: VEF}.Big().Add(BigMoney{M: big.NewInt(1), C: VEF})
+	if _, err := over.Money(); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("expected ErrMoneyOverflow, got %v", err)
+	}
+	if got := (BigMoney{}).String(); got != "0.00 " {
+		t.Errorf("expected nil M to be zero, got %q", got)
+	}
+}
+
+func TestParseBigMoney(t *testing.T) {
+	m := bigMoney(t, ZWL, "-123456789012345678901234.5")
+	if expected := "-12345678901234567890123450"; m.M.String() != expected {
+		t.Errorf("expected %s minor units, got %v", expected, m.M)
+	}
+	if got := m.String(); got != "-123456789012345678901234.50 ZWL" {
+		t.Errorf("unexpected String %q", got)
+	}
+	var perr *ParseError
+	if _, err := ParseBigMoney(USD, "1.234"); !errors.As(err, &perr) {
+		t.Errorf("expected a *ParseError for too many decimals, got %v", err)
+	}
+	if _, err := ParseBigMoney("XXY", "1"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
+
+func TestBigMoneyArithmetic(t *testing.T) {
+	a := bigMoney(t, IRR, "90000000000000000000")
+	b := bigMoney(t, IRR, "10000000000000000000.01")
+	if got := a.Add(b).String(); got != "100000000000000000000.01 IRR" {
+		t.Errorf("Add: got %s", got)
+	}
+	if got := a.Sub(b).String(); got != "79999999999999999999.99 IRR" {
+		t.Errorf("Sub: got %s", got)
+	}
+	if got := b.Neg().Abs(); got.M.Cmp(b.M) != 0 {
+		t.Errorf("Neg().Abs(): got %v", got)
+	}
+	if a.M.String() != "9000000000000000000000" {
+		t.Errorf("operands must not be modified, got %v", a.M)
+	}
+	if got := (BigMoney{}).Add(b); got.C != IRR {
+		t.Errorf("expected currency of the operand, got %q", got.C)
+	}
+	if c, err := a.Cmp(b); err != nil || c != 1 {
+		t.Errorf("Cmp: got %d, %v", c, err)
+	}
+	if _, err := a.AddChecked(bigMoney(t, USD, "1")); !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected ErrMoneyCurrencyMismatch, got %v", err)
+	}
+	if _, err := a.Cmp(bigMoney(t, USD, "1")); !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected ErrMoneyCurrencyMismatch, got %v", err)
+	}
+	convert := MoneyMath{Policy: MismatchConvert, Rates: testRates{EUR: {USD: big.NewRat(11, 10)}}}
+	if got, err := convert.AddBig(bigMoney(t, USD, "1"), bigMoney(t, EUR, "10")); err != nil || got.String() != "12.00 USD" {
+		t.Errorf("AddBig: expected 12.00 USD, got %v, %v", got, err)
+	}
+	if got, err := convert.SubBig(bigMoney(t, USD, "1"), bigMoney(t, EUR, "10")); err != nil || got.String() != "-10.00 USD" {
+		t.Errorf("SubBig: expected -10.00 USD, got %v, %v", got, err)
+	}
+	if got := b.MulRat(big.NewRat(1, 3)).String(); got != "3333333333333333333.34 IRR" {
+		t.Errorf("MulRat: got %s", got)
+	}
+	if got := b.MulRatRounded(big.NewRat(1, 3), RoundTruncate).String(); got != "3333333333333333333.33 IRR" {
+		t.Errorf("MulRatRounded: got %s", got)
+	}
+}
+
+func TestBigMoneyConvert(t *testing.T) {
+	rates := &StaticRates{}
+	rates.Set(VEF, USD, big.NewRat(1, 1000000), time.Date(2018, 8, 20, 0, 0, 0, 0, time.UTC))
+	m := bigMoney(t, VEF, "123456789012345678901.23")
+	got, err := m.Convert(USD, rates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "123456789012345.68 USD" {
+		t.Errorf("expected 123456789012345.68 USD, got %s", got)
+	}
+}
+
+func TestBigMoneySplit(t *testing.T) {
+	m := bigMoney(t, VEF, "100000000000000000000.01")
+	var got []string
+	for _, part := range m.Split(3) {
+		got = append(got, part.String())
+	}
+	expected := []string{"33333333333333333333.34 VEF", "33333333333333333333.34 VEF", "33333333333333333333.33 VEF"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Split: expected %v, got %v", expected, got)
+	}
+	if _, err := m.SplitChecked(0); !errors.Is(err, ErrMoneyZeroOrLessChunks) {
+		t.Errorf("expected ErrMoneyZeroOrLessChunks, got %v", err)
+	}
+
+	got = nil
+	for _, part := range (BigMoney{M: big.NewInt(100), C: CAD}).Allocate(1, 2, 4) {
+		got = append(got, part.String())
+	}
+	expected = []string{"0.14 CAD", "0.29 CAD", "0.57 CAD"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Allocate: expected %v, got %v", expected, got)
+	}
+}
+
+func TestBigMoneyFormat(t *testing.T) {
+	m := bigMoney(t, VEF, "-123456789012345678901234.56")
+	tests := []struct {
+		locale   string
+		opts     FormatOptions
+		expected string
+	}{
+		{"en_US", FormatOptions{Display: DisplayCode}, "(VEF\u00a0123,456,789,012,345,678,901,234.56)"},
+		{"de_DE", FormatOptions{Display: DisplayCode}, "-123.456.789.012.345.678.901.234,56 VEF"},
+		{"en_US", FormatOptions{Display: DisplayCode, MinorUnits: MinorUnitsNever}, "(VEF\u00a0123,456,789,012,345,678,901,235)"},
+		{"en_US", FormatOptions{Display: DisplayCode, Compact: true}, "(VEF\u00a0123,456,789,012T)"},
+		{"xx_XX", FormatOptions{}, "-123456789012345678901234.56 VEF"},
+	}
+	for _, test := range tests {
+		if got := m.FormatWith(test.locale, test.opts); got != test.expected {
+			t.Errorf("%s %+v: expected %q, got %q", test.locale, test.opts, test.expected, got)
+		}
+	}
+	for _, v := range []Money{{123456, USD}, {-5, EUR}} {
+		if expected, got := v.Format("de_DE"), v.Big().Format("de_DE"); got != expected {
+			t.Errorf("expected Format like Money %q, got %q", expected, got)
+		}
+	}
+}
+
+func TestBigMoneyJSON(t *testing.T) {
+	m := bigMoney(t, IRR, "123456789012345678901.23")
+	tests := []struct {
+		mode     JSONMode
+		expected string
+	}{
+		{JSONLegacy, `{"M":12345678901234567890123,"C":"IRR","F":123456789012345680000}`},
+		{JSONDecimal, `{"amount":"123456789012345678901.23","currency":"IRR"}`},
+		{JSONMinorUnits, `{"M":12345678901234567890123,"C":"IRR"}`},
+	}
+	for _, test := range tests {
+		b, err := json.Marshal(BigMoneyJSON{BigMoney: m, Mode: test.mode})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != test.expected {
+			t.Errorf("mode %d: expected %s, got %s", test.mode, test.expected, b)
+		}
+		var got BigMoney
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Errorf("mode %d: unexpected error: %v", test.mode, err)
+		} else if got.C != m.C || got.M.Cmp(m.M) != 0 {
+			t.Errorf("mode %d: expected %v, got %v", test.mode, m, got)
+		}
+
+		var small Money
+		if err := json.Unmarshal(b, &small); !errors.Is(err, ErrMoneyInvalidJSON) {
+			t.Errorf("mode %d: expected ErrMoneyInvalidJSON for Money, got %v", test.mode, err)
+		}
+	}
+
+	v := Money{M: 123456, C: USD}
+	a, _ := json.Marshal(v)
+	b, _ := json.Marshal(v.Big())
+	if string(a) != string(b) {
+		t.Errorf("expected JSON like Money %s, got %s", a, b)
+	}
+}
+
+func TestBigMoneyText(t *testing.T) {
+	m := bigMoney(t, ZWL, "100000000000000000000000.01")
+	b, err := m.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got BigMoney
+	if err := got.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != m.String() {
+		t.Errorf("expected %v, got %v", m, got)
+	}
+	if err := got.UnmarshalText([]byte("1.00")); err == nil {
+		t.Error("expected an error for a missing currency")
+	}
+	if b, _ := (BigMoney{}).MarshalText(); len(b) != 0 {
+		t.Errorf("expected empty text for the zero value, got %q", b)
+	}
+}
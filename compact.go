@@ -0,0 +1,75 @@
+package i18n
+
+import "math/big"
+
+// DefaultSignificantDigits is the number of significant digits of compact
+// numbers, if NumberOptions.SignificantDigits or
+// FormatOptions.SignificantDigits is 0.
+const DefaultSignificantDigits = 2
+
+// rootCompactSuffixes are used for languages without compact suffixes.
+var rootCompactSuffixes = []string{"K", "M", "G", "T"}
+
+// compactSuffixes returns the compact suffixes of language, for
+// thousands, millions, billions and trillions.
+func compactSuffixes(language string) []string {
+	if lang, found := Languages[language]; found && len(lang.CompactSuffixes) > 0 {
+		return lang.CompactSuffixes
+	}
+	return rootCompactSuffixes
+}
+
+// formatCompact formats the absolute value of r in the compact form of
+// language, e.g. 1.2K for 1234 in English, rounded to the given number of
+// significant digits. The integer part is never rounded away, so 123456 is
+// 123K rather than 120K. It reports whether the formatted value is negative
+// and whether it is exactly 1 without a suffix.
+func formatCompact(r *big.Rat, significant int, mode RoundingMode, language, decimalSep string, groupSizes []int, groupSep string) (negative bool, s string, one bool) {
+	if significant <= 0 {
+		significant = DefaultSignificantDigits
+	}
+	suffixes := compactSuffixes(language)
+	abs := new(big.Rat).Abs(r)
+
+	// Find the largest magnitude with a suffix that abs reaches
+	magnitude := 0
+	for i := len(suffixes); i > 0; i-- {
+		if suffixes[i-1] != "" && abs.Cmp(new(big.Rat).SetInt(pow10Int(3*i))) >= 0 {
+			magnitude = i
+			break
+		}
+	}
+
+	for {
+		scaled := new(big.Rat).Quo(r, new(big.Rat).SetInt(pow10Int(3*magnitude)))
+		intDigits := len(new(big.Int).Quo(new(big.Int).Abs(scaled.Num()), scaled.Denom()).String())
+		digits := significant - intDigits
+		if digits < 0 {
+			digits = 0
+		}
+		// Rounding may carry over into the next magnitude, e.g. 999999 is 1M
+		// rather than 1000K.
+		shifted := new(big.Rat).Mul(scaled, new(big.Rat).SetInt(pow10Int(digits)))
+		v := mode.round(shifted.Num(), shifted.Denom())
+		limit := new(big.Int).Mul(big.NewInt(1000), pow10Int(digits))
+		if next := nextCompactMagnitude(suffixes, magnitude); next > 0 && new(big.Int).Abs(v).Cmp(limit) >= 0 {
+			magnitude = next
+			continue
+		}
+
+		negative, s = formatRat(scaled, digits, mode, true, decimalSep, groupSizes, groupSep)
+		if magnitude == 0 {
+			return negative, s, v.Cmp(pow10Int(digits)) == 0
+		}
+		return negative, s + suffixes[magnitude-1], false
+	}
+}
+
+// nextCompactMagnitude returns the magnitude after the given one that has a
+// suffix, if it is exactly one step (a factor of 1000) larger, or 0.
+func nextCompactMagnitude(suffixes []string, magnitude int) int {
+	if magnitude < len(suffixes) && suffixes[magnitude] != "" {
+		return magnitude + 1
+	}
+	return 0
+}
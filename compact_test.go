@@ -0,0 +1,79 @@
+package i18n
+
+import "testing"
+
+func TestFormatNumberCompact(t *testing.T) {
+	tests := []struct {
+		locale   string
+		value    interface{}
+		opts     *NumberOptions
+		expected string
+	}{
+		{"en_US", 0, &NumberOptions{Compact: true}, "0"},
+		{"en_US", 1.234, &NumberOptions{Compact: true}, "1.2"},
+		{"en_US", 999, &NumberOptions{Compact: true}, "999"},
+		{"en_US", 1234, &NumberOptions{Compact: true}, "1.2K"},
+		{"en_US", 1000, &NumberOptions{Compact: true}, "1K"},
+		{"en_US", 12345, &NumberOptions{Compact: true}, "12K"},
+		{"en_US", 123456, &NumberOptions{Compact: true}, "123K"},
+		{"en_US", 999999, &NumberOptions{Compact: true}, "1M"},
+		{"en_US", -1500000, &NumberOptions{Compact: true}, "-1.5M"},
+		{"en_US", 1250000, &NumberOptions{Compact: true, Rounding: RoundHalfEven}, "1.2M"},
+		{"en_US", 1234567, &NumberOptions{Compact: true, SignificantDigits: 4}, "1.235M"},
+		{"en_US", 3.2e9, &NumberOptions{Compact: true}, "3.2B"},
+		{"en_US", 4.5e12, &NumberOptions{Compact: true}, "4.5T"},
+		{"en_US", 4.5e15, &NumberOptions{Compact: true}, "4,500T"},
+		{"de_DE", 1234, &NumberOptions{Compact: true}, "1,2\u00a0Tsd."},
+		{"de_DE", 3400000, &NumberOptions{Compact: true}, "3,4\u00a0Mio."},
+		{"de_DE", 2.5e9, &NumberOptions{Compact: true}, "2,5\u00a0Mrd."},
+		{"fr_FR", 1234, &NumberOptions{Compact: true}, "1,2\u00a0k"},
+		{"es_ES", 3.4e9, &NumberOptions{Compact: true}, "3,4\u00a0mil\u00a0M"},
+		{"it_IT", 12345, &NumberOptions{Compact: true}, "12.345"},
+		{"it_IT", 1234567, &NumberOptions{Compact: true}, "1,2\u00a0Mln"},
+		{"ja_JP", 1234, &NumberOptions{Compact: true}, "1.2K"},
+		{"xx_XX", 1234567, &NumberOptions{Compact: true}, "1.2M"},
+		{"en_US", 0.123, &NumberOptions{Compact: true}, "12%"},
+	}
+	for _, test := range tests {
+		format := FormatNumber
+		if test.expected == "12%" {
+			format = FormatPercent
+		}
+		got, err := format(test.locale, test.value, test.opts)
+		if err != nil {
+			t.Errorf("%s %v: unexpected error: %v", test.locale, test.value, err)
+		}
+		if got != test.expected {
+			t.Errorf("%s %v: expected %q, got %q", test.locale, test.value, test.expected, got)
+		}
+	}
+}
+
+func TestMoneyFormatCompact(t *testing.T) {
+	tests := []struct {
+		m        Money
+		locale   string
+		opts     FormatOptions
+		expected string
+	}{
+		{MakeMoney(USD, 1234), "en_US", FormatOptions{Compact: true}, "$1.2K"},
+		{MakeMoney(USD, 999.99), "en_US", FormatOptions{Compact: true}, "$1K"},
+		{MakeMoney(USD, 12.5), "en_US", FormatOptions{Compact: true}, "$13"},
+		{MakeMoney(USD, -1234567), "en_US", FormatOptions{Compact: true}, "($1.2M)"},
+		{MakeMoney(USD, 1234567), "en_US", FormatOptions{Compact: true, PlusSign: true}, "+$1.2M"},
+		{MakeMoney(EUR, 3400000), "de_DE", FormatOptions{Compact: true}, "3,4\u00a0Mio. €"},
+		{MakeMoney(EUR, -3400000), "de_DE", FormatOptions{Compact: true}, "-3,4\u00a0Mio. €"},
+		{MakeMoney(EUR, 1234567), "de_DE", FormatOptions{Compact: true, SignificantDigits: 3}, "1,23\u00a0Mio. €"},
+		{MakeMoney(USD, 1234), "en_US", FormatOptions{Compact: true, Display: DisplayCode}, "USD\u00a01.2K"},
+		{MakeMoney(USD, 1500000), "en_US", FormatOptions{Compact: true, Display: DisplayName}, "1.5M US dollars"},
+		{MakeMoney(USD, 1), "en_US", FormatOptions{Compact: true, Display: DisplayName}, "1 US dollar"},
+		{MakeMoney(EUR, 1500000), "fr_FR", FormatOptions{Compact: true, Display: DisplayName}, "1,5\u00a0M euros"},
+		{MakeMoney(USD, 1234), "xx_XX", FormatOptions{Compact: true}, "1234.00 USD"},
+	}
+	for _, test := range tests {
+		got := test.m.FormatWith(test.locale, test.opts)
+		if got != test.expected {
+			t.Errorf("%v in %s: expected %q, got %q", test.m, test.locale, test.expected, got)
+		}
+	}
+}
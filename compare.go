@@ -0,0 +1,146 @@
+package i18n
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrMoneyNoValues is returned by Min and Max when called without values.
+var ErrMoneyNoValues = errors.New("i18n: no money values")
+
+// Signum returns the sign of m: -1 if negative, 0 if zero and 1 if positive.
+// Unlike Sign, it tells zero apart from positive amounts.
+func (m Money) Signum() int {
+	switch {
+	case m.M < 0:
+		return -1
+	case m.M > 0:
+		return 1
+	}
+	return 0
+}
+
+// IsZero reports whether m is zero, in any currency.
+func (m Money) IsZero() bool {
+	return m.M == 0
+}
+
+// IsPositive reports whether m is greater than zero.
+func (m Money) IsPositive() bool {
+	return m.M > 0
+}
+
+// IsNegative reports whether m is less than zero.
+func (m Money) IsNegative() bool {
+	return m.M < 0
+}
+
+// Cmp compares m and n and returns -1 if m < n, 0 if m == n and 1 if m > n.
+// Unlike arithmetic, comparisons never convert between currencies: it
+// returns a *CurrencyMismatchError if the currencies of m and n differ,
+// regardless of any MoneyMath policy. An empty currency is compatible
+// with any other currency.
+func (m Money) Cmp(n Money) (int, error) {
+	if m.C != "" && n.C != "" && m.C != n.C {
+		return 0, &CurrencyMismatchError{A: m.C, B: n.C}
+	}
+	switch {
+	case m.M < n.M:
+		return -1, nil
+	case m.M > n.M:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Equals reports whether m and n are the same amount. Like Cmp, it returns
+// a *CurrencyMismatchError if the currencies of m and n differ.
+func (m Money) Equals(n Money) (bool, error) {
+	c, err := m.Cmp(n)
+	return c == 0 && err == nil, err
+}
+
+// Min returns the smallest of the given values. It returns
+// ErrMoneyNoValues if there are none, and a *CurrencyMismatchError if their
+// currencies differ.
+func Min(values ...Money) (Money, error) {
+	return extreme(values, -1)
+}
+
+// Max returns the largest of the given values. It returns
+// ErrMoneyNoValues if there are none, and a *CurrencyMismatchError if their
+// currencies differ.
+func Max(values ...Money) (Money, error) {
+	return extreme(values, 1)
+}
+
+// extreme returns the value v of values for which v.Cmp(w) == sign for all
+// other values w.
+func extreme(values []Money, sign int) (Money, error) {
+	if len(values) == 0 {
+		return Money{}, ErrMoneyNoValues
+	}
+	result := values[0]
+	for _, v := range values[1:] {
+		c, err := v.Cmp(result)
+		if err != nil {
+			return Money{}, err
+		}
+		if c == sign {
+			result = v
+		}
+		if result.C == "" {
+			result.C = v.C
+		}
+	}
+	return result, nil
+}
+
+// Sum returns the sum of the given values, or a zero Money without currency
+// if there are none. Values of different currencies return a
+// *CurrencyMismatchError; an empty currency is compatible with any other
+// currency. It returns ErrMoneyOverflow if the sum doesn't fit into a Money,
+// but intermediate sums may exceed that range.
+func Sum(values ...Money) (Money, error) {
+	return MoneyMath{}.Sum(values...)
+}
+
+// Sum is like the function Sum, but handles values of different currencies
+// according to a.Policy, converting into the currency of the first value.
+func (a MoneyMath) Sum(values ...Money) (Money, error) {
+	var c CurrencyCode
+	total := new(big.Int)
+	for _, v := range values {
+		n, err := a.operand(Money{C: c}, v)
+		if err != nil {
+			return Money{}, err
+		}
+		if c == "" {
+			c = n.C
+		}
+		total.Add(total, big.NewInt(n.M))
+	}
+	if !total.IsInt64() {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{M: total.Int64(), C: c}, nil
+}
+
+// MoneySlice attaches the methods of sort.Interface to []Money. Values are
+// sorted by currency first, and then by amount, so that a slice of mixed
+// currencies is sorted too.
+type MoneySlice []Money
+
+func (s MoneySlice) Len() int      { return len(s) }
+func (s MoneySlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s MoneySlice) Less(i, j int) bool {
+	if s[i].C != s[j].C {
+		return s[i].C < s[j].C
+	}
+	return s[i].M < s[j].M
+}
+
+// Sum returns the sum of the values of s (see Sum).
+func (s MoneySlice) Sum() (Money, error) {
+	return Sum(s...)
+}
@@ -0,0 +1,114 @@
+package i18n
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSignum(t *testing.T) {
+	tests := []struct {
+		m                        Money
+		signum                   int
+		zero, positive, negative bool
+	}{
+		{Money{M: -5, C: USD}, -1, false, false, true},
+		{Money{M: 0, C: USD}, 0, true, false, false},
+		{Money{M: 5, C: USD}, 1, false, true, false},
+	}
+	for _, test := range tests {
+		if got := test.m.Signum(); got != test.signum {
+			t.Errorf("%v: expected signum %d, got %d", test.m, test.signum, got)
+		}
+		if test.m.IsZero() != test.zero || test.m.IsPositive() != test.positive || test.m.IsNegative() != test.negative {
+			t.Errorf("%v: wrong IsZero, IsPositive or IsNegative", test.m)
+		}
+	}
+}
+
+func TestCmp(t *testing.T) {
+	tests := []struct {
+		m, n     Money
+		expected int
+	}{
+		{Money{M: 1, C: USD}, Money{M: 2, C: USD}, -1},
+		{Money{M: 2, C: USD}, Money{M: 2, C: USD}, 0},
+		{Money{M: 3, C: USD}, Money{M: 2, C: USD}, 1},
+		{Money{M: math.MinInt64, C: USD}, Money{M: math.MaxInt64, C: USD}, -1},
+		{Money{}, Money{M: 2, C: USD}, -1},
+	}
+	for _, test := range tests {
+		got, err := test.m.Cmp(test.n)
+		if err != nil || got != test.expected {
+			t.Errorf("%v.Cmp(%v): expected %d, got %d, %v", test.m, test.n, test.expected, got, err)
+		}
+		eq, err := test.m.Equals(test.n)
+		if err != nil || eq != (test.expected == 0) {
+			t.Errorf("%v.Equals(%v): got %t, %v", test.m, test.n, eq, err)
+		}
+	}
+
+	// Comparisons never convert
+	var mismatch *CurrencyMismatchError
+	if _, err := (Money{M: 1, C: USD}).Cmp(Money{M: 1, C: EUR}); !errors.As(err, &mismatch) || mismatch.A != USD || mismatch.B != EUR {
+		t.Errorf("expected *CurrencyMismatchError, got %v", err)
+	}
+	if eq, err := (Money{M: 1, C: USD}).Equals(Money{M: 1, C: EUR}); eq || !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected ErrMoneyCurrencyMismatch, got %t, %v", eq, err)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	values := []Money{{M: 5, C: EUR}, {M: -3, C: EUR}, {M: 12, C: EUR}, {M: -3, C: EUR}}
+	if got, err := Min(values...); err != nil || got != (Money{M: -3, C: EUR}) {
+		t.Errorf("Min: got %v, %v", got, err)
+	}
+	if got, err := Max(values...); err != nil || got != (Money{M: 12, C: EUR}) {
+		t.Errorf("Max: got %v, %v", got, err)
+	}
+	if got, err := Max(Money{}, Money{M: -1, C: EUR}); err != nil || got != (Money{C: EUR}) {
+		t.Errorf("Max: got %#v, %v", got, err)
+	}
+	if _, err := Min(); !errors.Is(err, ErrMoneyNoValues) {
+		t.Errorf("expected ErrMoneyNoValues, got %v", err)
+	}
+	if _, err := Max(Money{M: 1, C: EUR}, Money{M: 2, C: USD}); !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected ErrMoneyCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if got, err := Sum(); err != nil || got != (Money{}) {
+		t.Errorf("expected zero Money, got %v, %v", got, err)
+	}
+	if got, err := Sum(Money{M: 1, C: USD}, Money{M: 2, C: USD}, Money{M: -4, C: USD}); err != nil || got != (Money{M: -1, C: USD}) {
+		t.Errorf("expected -0.01 USD, got %v, %v", got, err)
+	}
+	if got, err := Sum(Money{M: math.MaxInt64, C: USD}, Money{M: 1, C: USD}, Money{M: -1, C: USD}); err != nil || got != (Money{M: math.MaxInt64, C: USD}) {
+		t.Errorf("expected MaxInt64, got %v, %v", got, err)
+	}
+	if _, err := Sum(Money{M: math.MaxInt64, C: USD}, Money{M: 1, C: USD}); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("expected ErrMoneyOverflow, got %v", err)
+	}
+
+	if _, err := (MoneySlice{{M: 1, C: USD}, {M: 1, C: EUR}}).Sum(); !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected ErrMoneyCurrencyMismatch, got %v", err)
+	}
+	math := MoneyMath{Policy: MismatchConvert, Rates: testRates{EUR: {USD: big.NewRat(11, 10)}}}
+	got, err := math.Sum(Money{M: 100, C: USD}, Money{M: 100, C: EUR})
+	if err != nil || got != (Money{M: 210, C: USD}) {
+		t.Errorf("expected 2.10 USD, got %v, %v", got, err)
+	}
+}
+
+func TestMoneySliceSort(t *testing.T) {
+	s := MoneySlice{{M: 5, C: USD}, {M: 3, C: EUR}, {M: -1, C: USD}, {M: 10, C: EUR}}
+	sort.Sort(s)
+	expected := MoneySlice{{M: 3, C: EUR}, {M: 10, C: EUR}, {M: -1, C: USD}, {M: 5, C: USD}}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("expected %v, got %v", expected, s)
+	}
+}
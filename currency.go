@@ -1,11 +1,32 @@
 package i18n
 
+import (
+	"math/big"
+)
+
 // Currency represets all details about a currency.
 type Currency struct {
 	// Code is the 3-letter ISO code of the currency
 	Code CurrencyCode
 	// Symbol is the common symbol used for the currency, e.g. € for Euro.
 	Symbol string
+	// Numeric is the 3-digit ISO 4217 numeric code of the currency, e.g. 978 for Euro.
+	Numeric string
+	// Digits is the ISO 4217 minor unit of the currency, i.e. the number of
+	// digits after the decimal point, e.g. 2 for Euro and 0 for Yen.
+	// Currencies without a minor unit, such as gold (XAU), use 2.
+	Digits int
+	// Name is the English ISO 4217 name of the currency, e.g. US Dollar.
+	Name string
+	// CashIncrement is the increment that cash payments are rounded to,
+	// in minor units of the currency, e.g. 5 for CHF, where cash amounts
+	// are rounded to 0.05. It is zero if cash amounts aren't rounded
+	// any further than other amounts.
+	CashIncrement int64
+	// CashDigits is the number of decimal digits shown for cash amounts
+	// (see FormatOptions.Cash), e.g. 0 for SEK. It is only meaningful if
+	// CashIncrement is set.
+	CashDigits int
 }
 
 func CurrencyForCountryCode(countryCode string) CurrencyCode {
@@ -16,3 +37,12 @@ func CurrencyForCountryCode(countryCode string) CurrencyCode {
 	}
 	return ""
 }
+
+// RateSource provides exchange rates between currencies. It is accepted by
+// Money.Convert, MoneyMath.Rates and MoneyBag.Collapse. See RateProvider
+// for rates with the time they were published.
+type RateSource interface {
+	// Rate returns the factor to multiply an amount in currency from with
+	// to get the equivalent amount in currency to.
+	Rate(from, to CurrencyCode) (*big.Rat, error)
+}
@@ -0,0 +1,231 @@
+package i18n
+
+// CurrencyName is the name of a currency in a specific language.
+type CurrencyName struct {
+	// One is the name used for an amount of one, e.g. US dollar.
+	One string
+	// Other is the name used for all other amounts, e.g. US dollars.
+	Other string
+}
+
+// CurrencyNames holds the localized names of currencies, by language code
+// (see Language.Code) and currency.
+var CurrencyNames = map[string]map[CurrencyCode]CurrencyName{
+	"en": {
+		AUD: {"Australian dollar", "Australian dollars"},
+		BRL: {"Brazilian real", "Brazilian reals"},
+		CAD: {"Canadian dollar", "Canadian dollars"},
+		CHF: {"Swiss franc", "Swiss francs"},
+		CNY: {"Chinese yuan", "Chinese yuan"},
+		CZK: {"Czech koruna", "Czech korunas"},
+		DKK: {"Danish krone", "Danish kroner"},
+		EUR: {"euro", "euros"},
+		GBP: {"British pound", "British pounds"},
+		HKD: {"Hong Kong dollar", "Hong Kong dollars"},
+		HUF: {"Hungarian forint", "Hungarian forints"},
+		INR: {"Indian rupee", "Indian rupees"},
+		JPY: {"Japanese yen", "Japanese yen"},
+		KWD: {"Kuwaiti dinar", "Kuwaiti dinars"},
+		MXN: {"Mexican peso", "Mexican pesos"},
+		NOK: {"Norwegian krone", "Norwegian kroner"},
+		NZD: {"New Zealand dollar", "New Zealand dollars"},
+		PLN: {"Polish zloty", "Polish zlotys"},
+		RUB: {"Russian ruble", "Russian rubles"},
+		SEK: {"Swedish krona", "Swedish kronor"},
+		USD: {"US dollar", "US dollars"},
+	},
+	"de": {
+		AUD: {"Australischer Dollar", "Australische Dollar"},
+		BRL: {"Brasilianischer Real", "Brasilianische Real"},
+		CAD: {"Kanadischer Dollar", "Kanadische Dollar"},
+		CHF: {"Schweizer Franken", "Schweizer Franken"},
+		CNY: {"Renminbi Yuan", "Renminbi Yuan"},
+		CZK: {"Tschechische Krone", "Tschechische Kronen"},
+		DKK: {"Dänische Krone", "Dänische Kronen"},
+		EUR: {"Euro", "Euro"},
+		GBP: {"Britisches Pfund", "Britische Pfund"},
+		HKD: {"Hongkong-Dollar", "Hongkong-Dollar"},
+		HUF: {"Ungarischer Forint", "Ungarische Forint"},
+		INR: {"Indische Rupie", "Indische Rupien"},
+		JPY: {"Japanischer Yen", "Japanische Yen"},
+		KWD: {"Kuwait-Dinar", "Kuwait-Dinar"},
+		MXN: {"Mexikanischer Peso", "Mexikanische Pesos"},
+		NOK: {"Norwegische Krone", "Norwegische Kronen"},
+		NZD: {"Neuseeland-Dollar", "Neuseeland-Dollar"},
+		PLN: {"Polnischer Złoty", "Polnische Złoty"},
+		RUB: {"Russischer Rubel", "Russische Rubel"},
+		SEK: {"Schwedische Krone", "Schwedische Kronen"},
+		USD: {"US-Dollar", "US-Dollar"},
+	},
+	"fr": {
+		AUD: {"dollar australien", "dollars australiens"},
+		BRL: {"réal brésilien", "réals brésiliens"},
+		CAD: {"dollar canadien", "dollars canadiens"},
+		CHF: {"franc suisse", "francs suisses"},
+		CNY: {"yuan renminbi chinois", "yuans renminbi chinois"},
+		CZK: {"couronne tchèque", "couronnes tchèques"},
+		DKK: {"couronne danoise", "couronnes danoises"},
+		EUR: {"euro", "euros"},
+		GBP: {"livre sterling", "livres sterling"},
+		HKD: {"dollar de Hong Kong", "dollars de Hong Kong"},
+		HUF: {"forint hongrois", "forints hongrois"},
+		INR: {"roupie indienne", "roupies indiennes"},
+		JPY: {"yen japonais", "yens japonais"},
+		KWD: {"dinar koweïtien", "dinars koweïtiens"},
+		MXN: {"peso mexicain", "pesos mexicains"},
+		NOK: {"couronne norvégienne", "couronnes norvégiennes"},
+		NZD: {"dollar néo-zélandais", "dollars néo-zélandais"},
+		PLN: {"zloty polonais", "zlotys polonais"},
+		RUB: {"rouble russe", "roubles russes"},
+		SEK: {"couronne suédoise", "couronnes suédoises"},
+		USD: {"dollar des États-Unis", "dollars des États-Unis"},
+	},
+	"es": {
+		AUD: {"dólar australiano", "dólares australianos"},
+		BRL: {"real brasileño", "reales brasileños"},
+		CAD: {"dólar canadiense", "dólares canadienses"},
+		CHF: {"franco suizo", "francos suizos"},
+		CNY: {"yuan", "yuanes"},
+		CZK: {"corona checa", "coronas checas"},
+		DKK: {"corona danesa", "coronas danesas"},
+		EUR: {"euro", "euros"},
+		GBP: {"libra esterlina", "libras esterlinas"},
+		HKD: {"dólar hongkonés", "dólares hongkoneses"},
+		HUF: {"forinto húngaro", "forintos húngaros"},
+		INR: {"rupia india", "rupias indias"},
+		JPY: {"yen", "yenes"},
+		KWD: {"dinar kuwaití", "dinares kuwaitíes"},
+		MXN: {"peso mexicano", "pesos mexicanos"},
+		NOK: {"corona noruega", "coronas noruegas"},
+		NZD: {"dólar neozelandés", "dólares neozelandeses"},
+		PLN: {"esloti", "eslotis"},
+		RUB: {"rublo ruso", "rublos rusos"},
+		SEK: {"corona sueca", "coronas suecas"},
+		USD: {"dólar estadounidense", "dólares estadounidenses"},
+	},
+	"pt": {
+		AUD: {"dólar australiano", "dólares australianos"},
+		BRL: {"real brasileiro", "reais brasileiros"},
+		CAD: {"dólar canadense", "dólares canadenses"},
+		CHF: {"franco suíço", "francos suíços"},
+		CNY: {"yuan chinês", "yuans chineses"},
+		CZK: {"coroa tcheca", "coroas tchecas"},
+		DKK: {"coroa dinamarquesa", "coroas dinamarquesas"},
+		EUR: {"euro", "euros"},
+		GBP: {"libra esterlina", "libras esterlinas"},
+		HKD: {"dólar de Hong Kong", "dólares de Hong Kong"},
+		HUF: {"florim húngaro", "florins húngaros"},
+		INR: {"rupia indiana", "rupias indianas"},
+		JPY: {"iene japonês", "ienes japoneses"},
+		KWD: {"dinar kuwaitiano", "dinares kuwaitianos"},
+		MXN: {"peso mexicano", "pesos mexicanos"},
+		NOK: {"coroa norueguesa", "coroas norueguesas"},
+		NZD: {"dólar neozelandês", "dólares neozelandeses"},
+		PLN: {"zloty polonês", "zlotys poloneses"},
+		RUB: {"rublo russo", "rublos russos"},
+		SEK: {"coroa sueca", "coroas suecas"},
+		USD: {"dólar americano", "dólares americanos"},
+	},
+}
+
+// currencyName returns the name of currency c in the given language for
+// an amount that is (or isn't) grammatically singular. It falls back to the
+// English ISO 4217 name, and to the code for unknown currencies.
+func currencyName(language string, c CurrencyCode, one bool) string {
+	if name, found := CurrencyNames[language][c]; found {
+		if one {
+			return name.One
+		}
+		return name.Other
+	}
+	if curr, found := Currencies[c]; found && curr.Name != "" {
+		return curr.Name
+	}
+	return string(c)
+}
+
+// CurrencyUnit holds the names of the major and minor unit of a currency
+// in a specific language, e.g. dollar and cent, as used by Money.SpellOut.
+type CurrencyUnit struct {
+	// Major is the name of the major unit, e.g. dollar.
+	Major CurrencyName
+	// Minor is the name of the minor unit, e.g. cent.
+	// It is empty for currencies without minor units.
+	Minor CurrencyName
+	// Feminine reports whether the major unit is grammatically feminine,
+	// which changes the number in some languages, e.g. une livre in French.
+	Feminine bool
+	// MinorFeminine reports whether the minor unit is grammatically feminine.
+	MinorFeminine bool
+}
+
+// CurrencyUnits holds the localized unit names of currencies, by language
+// code (see Language.Code) and currency. Currencies that are missing are
+// spelled out with their name (see CurrencyNames).
+var CurrencyUnits = map[string]map[CurrencyCode]CurrencyUnit{
+	"en": {
+		AUD: {Major: CurrencyName{"dollar", "dollars"}, Minor: CurrencyName{"cent", "cents"}},
+		BRL: {Major: CurrencyName{"real", "reais"}, Minor: CurrencyName{"centavo", "centavos"}},
+		CAD: {Major: CurrencyName{"dollar", "dollars"}, Minor: CurrencyName{"cent", "cents"}},
+		CHF: {Major: CurrencyName{"franc", "francs"}, Minor: CurrencyName{"centime", "centimes"}},
+		EUR: {Major: CurrencyName{"euro", "euros"}, Minor: CurrencyName{"cent", "cents"}},
+		GBP: {Major: CurrencyName{"pound", "pounds"}, Minor: CurrencyName{"penny", "pence"}},
+		JPY: {Major: CurrencyName{"yen", "yen"}},
+		KWD: {Major: CurrencyName{"dinar", "dinars"}, Minor: CurrencyName{"fils", "fils"}},
+		MXN: {Major: CurrencyName{"peso", "pesos"}, Minor: CurrencyName{"centavo", "centavos"}},
+		NZD: {Major: CurrencyName{"dollar", "dollars"}, Minor: CurrencyName{"cent", "cents"}},
+		USD: {Major: CurrencyName{"dollar", "dollars"}, Minor: CurrencyName{"cent", "cents"}},
+	},
+	"de": {
+		AUD: {Major: CurrencyName{"Dollar", "Dollar"}, Minor: CurrencyName{"Cent", "Cent"}},
+		BRL: {Major: CurrencyName{"Real", "Real"}, Minor: CurrencyName{"Centavo", "Centavos"}},
+		CAD: {Major: CurrencyName{"Dollar", "Dollar"}, Minor: CurrencyName{"Cent", "Cent"}},
+		CHF: {Major: CurrencyName{"Franken", "Franken"}, Minor: CurrencyName{"Rappen", "Rappen"}},
+		DKK: {Major: CurrencyName{"Krone", "Kronen"}, Minor: CurrencyName{"Öre", "Öre"}, Feminine: true},
+		EUR: {Major: CurrencyName{"Euro", "Euro"}, Minor: CurrencyName{"Cent", "Cent"}},
+		GBP: {Major: CurrencyName{"Pfund", "Pfund"}, Minor: CurrencyName{"Penny", "Pence"}},
+		JPY: {Major: CurrencyName{"Yen", "Yen"}},
+		KWD: {Major: CurrencyName{"Dinar", "Dinar"}, Minor: CurrencyName{"Fils", "Fils"}},
+		MXN: {Major: CurrencyName{"Peso", "Pesos"}, Minor: CurrencyName{"Centavo", "Centavos"}},
+		NOK: {Major: CurrencyName{"Krone", "Kronen"}, Minor: CurrencyName{"Øre", "Øre"}, Feminine: true},
+		SEK: {Major: CurrencyName{"Krone", "Kronen"}, Minor: CurrencyName{"Öre", "Öre"}, Feminine: true},
+		USD: {Major: CurrencyName{"Dollar", "Dollar"}, Minor: CurrencyName{"Cent", "Cent"}},
+	},
+	"fr": {
+		AUD: {Major: CurrencyName{"dollar", "dollars"}, Minor: CurrencyName{"cent", "cents"}},
+		BRL: {Major: CurrencyName{"réal", "réals"}, Minor: CurrencyName{"centavo", "centavos"}},
+		CAD: {Major: CurrencyName{"dollar", "dollars"}, Minor: CurrencyName{"cent", "cents"}},
+		CHF: {Major: CurrencyName{"franc", "francs"}, Minor: CurrencyName{"centime", "centimes"}},
+		DKK: {Major: CurrencyName{"couronne", "couronnes"}, Minor: CurrencyName{"øre", "øre"}, Feminine: true},
+		EUR: {Major: CurrencyName{"euro", "euros"}, Minor: CurrencyName{"centime", "centimes"}},
+		GBP: {Major: CurrencyName{"livre", "livres"}, Minor: CurrencyName{"penny", "pence"}, Feminine: true},
+		JPY: {Major: CurrencyName{"yen", "yens"}},
+		KWD: {Major: CurrencyName{"dinar", "dinars"}, Minor: CurrencyName{"fils", "fils"}},
+		MXN: {Major: CurrencyName{"peso", "pesos"}, Minor: CurrencyName{"centavo", "centavos"}},
+		USD: {Major: CurrencyName{"dollar", "dollars"}, Minor: CurrencyName{"cent", "cents"}},
+	},
+	"es": {
+		AUD: {Major: CurrencyName{"dólar", "dólares"}, Minor: CurrencyName{"centavo", "centavos"}},
+		BRL: {Major: CurrencyName{"real", "reales"}, Minor: CurrencyName{"centavo", "centavos"}},
+		CAD: {Major: CurrencyName{"dólar", "dólares"}, Minor: CurrencyName{"centavo", "centavos"}},
+		CHF: {Major: CurrencyName{"franco", "francos"}, Minor: CurrencyName{"céntimo", "céntimos"}},
+		EUR: {Major: CurrencyName{"euro", "euros"}, Minor: CurrencyName{"céntimo", "céntimos"}},
+		GBP: {Major: CurrencyName{"libra", "libras"}, Minor: CurrencyName{"penique", "peniques"}, Feminine: true},
+		JPY: {Major: CurrencyName{"yen", "yenes"}},
+		KWD: {Major: CurrencyName{"dinar", "dinares"}, Minor: CurrencyName{"fils", "fils"}},
+		MXN: {Major: CurrencyName{"peso", "pesos"}, Minor: CurrencyName{"centavo", "centavos"}},
+		USD: {Major: CurrencyName{"dólar", "dólares"}, Minor: CurrencyName{"centavo", "centavos"}},
+	},
+	"pt": {
+		AUD: {Major: CurrencyName{"dólar", "dólares"}, Minor: CurrencyName{"centavo", "centavos"}},
+		BRL: {Major: CurrencyName{"real", "reais"}, Minor: CurrencyName{"centavo", "centavos"}},
+		CAD: {Major: CurrencyName{"dólar", "dólares"}, Minor: CurrencyName{"centavo", "centavos"}},
+		CHF: {Major: CurrencyName{"franco", "francos"}, Minor: CurrencyName{"cêntimo", "cêntimos"}},
+		EUR: {Major: CurrencyName{"euro", "euros"}, Minor: CurrencyName{"cêntimo", "cêntimos"}},
+		GBP: {Major: CurrencyName{"libra", "libras"}, Minor: CurrencyName{"pêni", "pence"}, Feminine: true},
+		JPY: {Major: CurrencyName{"iene", "ienes"}},
+		KWD: {Major: CurrencyName{"dinar", "dinares"}, Minor: CurrencyName{"fils", "fils"}},
+		MXN: {Major: CurrencyName{"peso", "pesos"}, Minor: CurrencyName{"centavo", "centavos"}},
+		USD: {Major: CurrencyName{"dólar", "dólares"}, Minor: CurrencyName{"centavo", "centavos"}},
+	},
+}
@@ -50,3 +50,54 @@ func TestCurrencyForCountryCode(t *testing.T) {
 		}
 	}
 }
+
+func TestCurrencyISOData(t *testing.T) {
+	var tests = []struct {
+		code    CurrencyCode
+		numeric string
+		digits  int
+		name    string
+	}{
+		{EUR, "978", 2, "Euro"},
+		{USD, "840", 2, "US Dollar"},
+		{JPY, "392", 0, "Yen"},
+		{KWD, "414", 3, "Kuwaiti Dinar"},
+		{BHD, "048", 3, "Bahraini Dinar"},
+		{TND, "788", 3, "Tunisian Dinar"},
+		{CLF, "990", 4, "Unidad de Fomento"},
+		{XAU, "959", 2, "Gold"},
+		{XDR, "960", 2, "SDR (Special Drawing Right)"},
+	}
+	for _, test := range tests {
+		c, found := Currencies[test.code]
+		if !found {
+			t.Errorf("expected currency %s to be found", test.code)
+			continue
+		}
+		if c.Numeric != test.numeric {
+			t.Errorf("%s: expected Numeric to be %s, got %s", test.code, test.numeric, c.Numeric)
+		}
+		if c.Digits != test.digits {
+			t.Errorf("%s: expected Digits to be %d, got %d", test.code, test.digits, c.Digits)
+		}
+		if c.Name != test.name {
+			t.Errorf("%s: expected Name to be %s, got %s", test.code, test.name, c.Name)
+		}
+	}
+}
+
+func TestCurrencyCodesAreConsistent(t *testing.T) {
+	numerics := make(map[string]CurrencyCode)
+	for code, c := range Currencies {
+		if c.Code != code {
+			t.Errorf("expected Code of %s to be %s, got %s", code, code, c.Code)
+		}
+		if len(c.Numeric) != 3 {
+			t.Errorf("%s: expected a 3-digit numeric code, got %q", code, c.Numeric)
+		}
+		if other, found := numerics[c.Numeric]; found {
+			t.Errorf("%s and %s share the numeric code %s", code, other, c.Numeric)
+		}
+		numerics[c.Numeric] = code
+	}
+}
@@ -0,0 +1,261 @@
+package i18n
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ErrInvalidEncoding is returned when decoding data that wasn't produced
+// by the matching encoder, e.g. a gob of an unknown version.
+var ErrInvalidEncoding = errors.New("i18n: invalid encoding")
+
+// gobVersion is the version of the gob encodings of Money and Address.
+// Gob streams of releases before the first version encoded them as
+// structs, which package gob can't decode into these types; see
+// CHANGELOG.md.
+const gobVersion = 1
+
+// MarshalText implements encoding.TextMarshaler. It returns the ISO code.
+func (c CurrencyCode) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It returns
+// ErrUnknownCurrency for codes that aren't in Currencies. An empty text
+// is the zero value.
+func (c *CurrencyCode) UnmarshalText(b []byte) error {
+	code := CurrencyCode(b)
+	if code != "" {
+		if err := code.validate(); err != nil {
+			return err
+		}
+	}
+	*c = code
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler. It encodes the ISO code as
+// character data, e.g. <currency>USD</currency>.
+func (c CurrencyCode) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(string(c), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler, like UnmarshalText.
+func (c *CurrencyCode) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// GobEncode implements gob.GobEncoder.
+func (c CurrencyCode) GobEncode() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// GobDecode implements gob.GobDecoder, like UnmarshalText.
+func (c *CurrencyCode) GobDecode(b []byte) error {
+	return c.UnmarshalText(b)
+}
+
+// MarshalText implements encoding.TextMarshaler. It returns the canonical
+// text form of Money.String, e.g. "1234.56 USD", or an empty text for the
+// zero value. Other values must have a known currency, as UnmarshalText
+// would reject them otherwise.
+func (m Money) MarshalText() ([]byte, error) {
+	if m == (Money{}) {
+		return nil, nil
+	}
+	if err := m.C.validate(); err != nil {
+		return nil, err
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// canonical text form of Money.String, e.g. "1234.56 USD". Errors are of
+// type *ParseError.
+func (m *Money) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*m = Money{}
+		return nil
+	}
+	v, err := parseMoneyString(string(b))
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler. It encodes the amount as a decimal
+// and the currency as an attribute, e.g.
+// <price currency="USD">1234.56</price>. As an attribute, Money is
+// encoded like MarshalText. Like MarshalText, it returns an error for a
+// nonzero value without a known currency.
+func (m Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if m == (Money{}) {
+		return e.EncodeElement("", start)
+	}
+	if err := m.C.validate(); err != nil {
+		return err
+	}
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "currency"}, Value: string(m.C)})
+	return e.EncodeElement(m.decimal(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler. It accepts the form of
+// MarshalXML, and the canonical text form without a currency attribute,
+// e.g. <price>1234.56 USD</price>.
+func (m *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	for _, attr := range start.Attr {
+		if attr.Name.Local != "currency" {
+			continue
+		}
+		c := CurrencyCode(attr.Value)
+		if err := c.validate(); err != nil {
+			return err
+		}
+		v, err := parseDecimal(s, c, s)
+		if err != nil {
+			return err
+		}
+		*m = v
+		return nil
+	}
+	return m.UnmarshalText([]byte(s))
+}
+
+// GobEncode implements gob.GobEncoder. The encoding is a version byte,
+// the minor units as a varint and the ISO code.
+func (m Money) GobEncode() ([]byte, error) {
+	b := make([]byte, 1, 1+binary.MaxVarintLen64+len(m.C))
+	b[0] = gobVersion
+	b = b[:1+binary.PutVarint(b[1:1+binary.MaxVarintLen64], m.M)]
+	return append(b, m.C...), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (m *Money) GobDecode(b []byte) error {
+	if len(b) == 0 || b[0] != gobVersion {
+		return fmt.Errorf("%w: unsupported Money gob version", ErrInvalidEncoding)
+	}
+	v, n := binary.Varint(b[1:])
+	if n <= 0 {
+		return fmt.Errorf("%w: invalid Money gob amount", ErrInvalidEncoding)
+	}
+	var c CurrencyCode
+	if err := c.UnmarshalText(b[1+n:]); err != nil {
+		return err
+	}
+	*m = Money{M: v, C: c}
+	return nil
+}
+
+// addressFields maps the keys of the text form of Address to its fields.
+func (a *Address) addressFields() map[string]*string {
+	return map[string]*string{
+		"StreetAddress":   &a.StreetAddress,
+		"ExtendedAddress": &a.ExtendedAddress,
+		"Locality":        &a.Locality,
+		"PostalCode":      &a.PostalCode,
+		"Region":          &a.Region,
+		"Country":         &a.Country,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler. The text form is a URL
+// query of the non-empty fields, sorted by name, e.g.
+// "Country=DE&Locality=M%C3%BCnchen&StreetAddress=Marienplatz+2a".
+func (a Address) MarshalText() ([]byte, error) {
+	values := url.Values{}
+	for key, field := range a.addressFields() {
+		if *field != "" {
+			values.Set(key, *field)
+		}
+	}
+	return []byte(values.Encode()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown and repeated
+// fields are rejected.
+func (a *Address) UnmarshalText(b []byte) error {
+	values, err := url.ParseQuery(string(b))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+	var v Address
+	fields := v.addressFields()
+	for key, value := range values {
+		field, found := fields[key]
+		if !found {
+			return fmt.Errorf("%w: unknown Address field %q", ErrInvalidEncoding, key)
+		}
+		if len(value) > 1 {
+			return fmt.Errorf("%w: repeated Address field %q", ErrInvalidEncoding, key)
+		}
+		*field = value[0]
+	}
+	*a = v
+	return nil
+}
+
+// addressStruct has the fields of Address, but none of its methods, so
+// that the JSON and XML encoders encode it field by field.
+type addressStruct struct {
+	StreetAddress   string
+	ExtendedAddress string
+	Locality        string
+	PostalCode      string
+	Region          string
+	Country         string
+}
+
+// MarshalJSON implements json.Marshaler. Address is encoded as an object
+// with a member per field, rather than in its text form.
+func (a Address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(addressStruct(a))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *Address) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, (*addressStruct)(a))
+}
+
+// MarshalXML implements xml.Marshaler. It encodes the fields as child
+// elements, e.g. <address><StreetAddress>…</StreetAddress>…</address>.
+func (a Address) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(addressStruct(a), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (a *Address) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return d.DecodeElement((*addressStruct)(a), &start)
+}
+
+// GobEncode implements gob.GobEncoder. The encoding is a version byte
+// followed by the text form.
+func (a Address) GobEncode() ([]byte, error) {
+	text, err := a.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{gobVersion}, text...), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (a *Address) GobDecode(b []byte) error {
+	if len(b) == 0 || b[0] != gobVersion {
+		return fmt.Errorf("%w: unsupported Address gob version", ErrInvalidEncoding)
+	}
+	return a.UnmarshalText(b[1:])
+}
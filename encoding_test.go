@@ -0,0 +1,195 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMoneyText(t *testing.T) {
+	tests := []struct {
+		m    Money
+		text string
+	}{
+		{Money{M: 123456, C: USD}, "1234.56 USD"},
+		{Money{M: -5, C: EUR}, "-0.05 EUR"},
+		{Money{M: 1234, C: JPY}, "1234 JPY"},
+		{Money{}, ""},
+	}
+	for _, test := range tests {
+		b, err := test.m.MarshalText()
+		if err != nil || string(b) != test.text {
+			t.Errorf("%#v: expected %q, got %q, %v", test.m, test.text, b, err)
+		}
+		var m Money
+		if err := m.UnmarshalText([]byte(test.text)); err != nil || m != test.m {
+			t.Errorf("%q: expected %#v, got %#v, %v", test.text, test.m, m, err)
+		}
+	}
+	var m Money
+	var perr *ParseError
+	if err := m.UnmarshalText([]byte("1234.56 XXY")); !errors.As(err, &perr) || !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected *ParseError for ErrUnknownCurrency, got %v", err)
+	}
+	for _, m := range []Money{{M: 5}, {M: 5, C: "XXY"}} {
+		if _, err := m.MarshalText(); !errors.Is(err, ErrUnknownCurrency) {
+			t.Errorf("%#v: expected ErrUnknownCurrency, got %v", m, err)
+		}
+	}
+}
+
+func TestCurrencyCodeText(t *testing.T) {
+	var c CurrencyCode
+	if err := c.UnmarshalText([]byte("EUR")); err != nil || c != EUR {
+		t.Errorf("expected EUR, got %v, %v", c, err)
+	}
+	if err := c.UnmarshalText([]byte("XXY")); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`"xxy"`), &c); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
+
+func TestAddressText(t *testing.T) {
+	a := Address{StreetAddress: "Marienplatz 2a", Locality: "München", Country: "DE"}
+	b, err := a.MarshalText()
+	expected := "Country=DE&Locality=M%C3%BCnchen&StreetAddress=Marienplatz+2a"
+	if err != nil || string(b) != expected {
+		t.Errorf("expected %q, got %q, %v", expected, b, err)
+	}
+	var got Address
+	if err := got.UnmarshalText(b); err != nil || got != a {
+		t.Errorf("expected %+v, got %+v, %v", a, got, err)
+	}
+	for _, text := range []string{"Street=x", "Country=DE&Country=AT", "Country=%zz"} {
+		if err := got.UnmarshalText([]byte(text)); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("%q: expected ErrInvalidEncoding, got %v", text, err)
+		}
+	}
+}
+
+func TestAddressJSON(t *testing.T) {
+	a := Address{StreetAddress: "Marienplatz 2a", Locality: "München", Country: "DE"}
+	b, err := json.Marshal(a)
+	expected := `{"StreetAddress":"Marienplatz 2a","ExtendedAddress":"","Locality":"München","PostalCode":"","Region":"","Country":"DE"}`
+	if err != nil || string(b) != expected {
+		t.Errorf("expected %s, got %s, %v", expected, b, err)
+	}
+	var got Address
+	if err := json.Unmarshal(b, &got); err != nil || got != a {
+		t.Errorf("expected %+v, got %+v, %v", a, got, err)
+	}
+}
+
+type xmlInvoice struct {
+	XMLName  xml.Name     `xml:"invoice"`
+	Currency CurrencyCode `xml:"currency"`
+	Total    Money        `xml:"total"`
+	Tax      Money        `xml:"tax,attr"`
+	Address  Address      `xml:"address"`
+}
+
+func TestXML(t *testing.T) {
+	invoice := xmlInvoice{
+		XMLName:  xml.Name{Local: "invoice"},
+		Currency: USD,
+		Total:    Money{M: 123456, C: USD},
+		Tax:      Money{M: 1999, C: USD},
+		Address:  Address{StreetAddress: "1 Main St", Locality: "Springfield", Country: "US"},
+	}
+	b, err := xml.Marshal(invoice)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `<invoice tax="19.99 USD"><currency>USD</currency><total currency="USD">1234.56</total>` +
+		`<address><StreetAddress>1 Main St</StreetAddress><ExtendedAddress></ExtendedAddress><Locality>Springfield</Locality>` +
+		`<PostalCode></PostalCode><Region></Region><Country>US</Country></address></invoice>`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+	var got xmlInvoice
+	if err := xml.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, invoice) {
+		t.Errorf("expected %+v, got %+v", invoice, got)
+	}
+
+	tests := []struct {
+		xml      string
+		expected Money
+		fails    bool
+	}{
+		{`<total>1234.56 EUR</total>`, Money{M: 123456, C: EUR}, false},
+		{`<total currency="KWD">1.5</total>`, Money{M: 1500, C: KWD}, false},
+		{`<total></total>`, Money{}, false},
+		{`<total currency="XXY">1.5</total>`, Money{}, true},
+		{`<total currency="USD">1.555</total>`, Money{}, true},
+		{`<total currency="USD">1.55 USD</total>`, Money{}, true},
+	}
+	for _, test := range tests {
+		var m Money
+		err := xml.Unmarshal([]byte(test.xml), &m)
+		if (err != nil) != test.fails {
+			t.Errorf("%s: expected failure %t, got %v", test.xml, test.fails, err)
+		}
+		if m != test.expected {
+			t.Errorf("%s: expected %#v, got %#v", test.xml, test.expected, m)
+		}
+	}
+
+	for _, m := range []Money{{M: 5}, {M: 5, C: "XXY"}} {
+		if _, err := xml.Marshal(xmlInvoice{Currency: USD, Total: m}); !errors.Is(err, ErrUnknownCurrency) {
+			t.Errorf("%#v: expected ErrUnknownCurrency, got %v", m, err)
+		}
+	}
+	for _, m := range []Money{{}, {C: EUR}, {M: -1, C: BHD}} {
+		b, err := xml.Marshal(m)
+		if err != nil {
+			t.Fatalf("%#v: unexpected error: %v", m, err)
+		}
+		var got Money
+		if err := xml.Unmarshal(b, &got); err != nil || got != m {
+			t.Errorf("%s: expected %#v, got %#v, %v", b, m, got, err)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	type record struct {
+		Amounts  []Money
+		Currency CurrencyCode
+		Address  Address
+	}
+	r := record{
+		Amounts:  []Money{{M: 123456, C: USD}, {M: -9223372036854775808, C: JPY}, {}},
+		Currency: EUR,
+		Address:  Address{StreetAddress: "Marienplatz 2a", Locality: "München", Country: "DE"},
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got record
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, r) {
+		t.Errorf("expected %+v, got %+v", r, got)
+	}
+
+	var m Money
+	for _, b := range [][]byte{nil, {2, 0}, {1}} {
+		if err := m.GobDecode(b); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("%v: expected ErrInvalidEncoding, got %v", b, err)
+		}
+	}
+	if err := m.GobDecode([]byte{1, 2, 'X', 'X', 'Y'}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
@@ -0,0 +1,201 @@
+package i18n
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SymbolDisplay specifies how Money.FormatWith shows the currency.
+type SymbolDisplay int
+
+const (
+	// DisplaySymbol shows the common symbol of the currency, e.g. € or HK$
+	// (see Currency.Symbol). This is what Money.Format does.
+	DisplaySymbol SymbolDisplay = iota
+	// DisplayNarrowSymbol shows the narrowest symbol of the currency,
+	// e.g. $ rather than HK$.
+	DisplayNarrowSymbol
+	// DisplayLocaleSymbol shows the symbol that the locale uses for its own
+	// currency (see Locale.CurrencySymbol), e.g. CHF rather than fr. for de_CH.
+	// Other currencies are displayed like DisplaySymbol.
+	DisplayLocaleSymbol
+	// DisplayCode shows the ISO code of the currency, e.g. USD.
+	DisplayCode
+	// DisplayName shows the name of the currency in the language of the
+	// locale, e.g. 1,234.56 US dollars (see CurrencyNames).
+	DisplayName
+)
+
+// MinorUnits specifies whether Money.FormatWith shows the minor units,
+// e.g. the cents of a dollar amount.
+type MinorUnits int
+
+const (
+	// MinorUnitsAlways always shows the minor units, e.g. $5.00.
+	MinorUnitsAlways MinorUnits = iota
+	// MinorUnitsOmitZero omits the minor units if they are zero,
+	// e.g. $5 but $5.50.
+	MinorUnitsOmitZero
+	// MinorUnitsNever rounds to whole units (see FormatOptions.Rounding)
+	// and never shows the minor units, e.g. $6 for 5.50.
+	MinorUnitsNever
+)
+
+// FormatOptions controls how Money.FormatWith formats an amount.
+// The zero value formats like Money.Format.
+type FormatOptions struct {
+	// Display specifies how the currency is shown.
+	Display SymbolDisplay
+	// PlusSign shows the positive sign of the locale for positive amounts,
+	// at the position where negative amounts show the negative sign.
+	PlusSign bool
+	// MinorUnits specifies whether the minor units are shown.
+	MinorUnits MinorUnits
+	// Rounding is the rounding mode used by MinorUnitsNever and Compact.
+	Rounding RoundingMode
+	// Compact abbreviates large amounts with the suffixes of the language
+	// (see Language.CompactSuffixes), e.g. $1.2K for en_US and 3,4 Mio. €
+	// for de_DE. MinorUnits is ignored.
+	Compact bool
+	// SignificantDigits is the number of significant digits of compact
+	// amounts, or 0 for DefaultSignificantDigits.
+	SignificantDigits int
+	// Accounting shows negative amounts in parentheses rather than with
+	// the negative pattern of the locale, e.g. ($1,234.56) for en_US and
+	// (1.234,56 €) for de_DE.
+	Accounting bool
+	// Width is the minimum width of the result in characters. Shorter
+	// results are padded with spaces between the currency and the number
+	// if the currency comes first, e.g. "$    12.34", and in front
+	// otherwise, e.g. "   12,34 €", so that amounts are right-aligned in
+	// fixed-width columns. With Accounting, positive amounts are followed
+	// by a space to line up with the parenthesis of negative amounts.
+	Width int
+	// Cash formats the amount payable in cash: it is rounded like
+	// Money.RoundCash and shown with the cash digits of the currency (see
+	// Currency.CashDigits), e.g. 12 kr rather than 12,37 kr for SEK.
+	Cash bool
+}
+
+// narrowSymbols lists the narrow symbols of currencies whose
+// symbol (see Currency.Symbol) isn't narrow already.
+var narrowSymbols = map[CurrencyCode]string{
+	BZD: "$",
+	DOP: "$",
+	HKD: "$",
+	JMD: "$",
+	TTD: "$",
+	TWD: "$",
+	UYU: "$",
+	BOB: "Bs",
+	PHP: "₱",
+	INR: "₹",
+	NPR: "₨",
+	PKR: "₨",
+	LKR: "₨",
+	TRY: "₺",
+	RUB: "₽",
+	KZT: "₸",
+	GEL: "₾",
+	AZN: "₼",
+}
+
+// currencyDisplay returns the text to show for the currency of m in locale l.
+func (m Money) currencyDisplay(l *Locale, display SymbolDisplay) string {
+	curr, found := Currencies[m.C]
+	switch display {
+	case DisplayCode:
+		return string(m.C)
+	case DisplayNarrowSymbol:
+		if sym, found := narrowSymbols[m.C]; found {
+			return sym
+		}
+	case DisplayLocaleSymbol:
+		if l.CurrencyCode == m.C && l.CurrencySymbol != "" {
+			return l.CurrencySymbol
+		}
+	}
+	if found {
+		return curr.Symbol
+	}
+	return string(m.C)
+}
+
+// pluralOne reports whether an amount with the given whole and fractional
+// part takes the singular form in language, e.g. "1 US dollar" but
+// "1.50 US dollars" in English. fracShown reports whether any fraction
+// digits are shown at all.
+func pluralOne(language string, whole, frac uint64, fracShown bool) bool {
+	switch language {
+	case "fr", "pt":
+		return whole <= 1
+	case "es", "it":
+		return whole == 1 && frac == 0
+	}
+	return whole == 1 && !fracShown
+}
+
+// plusPattern returns the pattern for positive amounts with a plus sign.
+// The sign goes where the negative pattern of the locale puts the minus
+// sign, or in front if the locale uses parentheses.
+func plusPattern(l *Locale) string {
+	if strings.Contains(l.CurrencyNegativePattern, "-") {
+		return strings.Replace(l.CurrencyNegativePattern, "-", "+", 1)
+	}
+	return "+" + l.CurrencyPositivePattern
+}
+
+// currencySpacing inserts a no-break space between the currency and the
+// number in pattern, if they are adjacent and the currency text ends (or
+// starts) with a letter, e.g. "$n" becomes "$\u00a0n" for USD.
+func currencySpacing(pattern, currency string) string {
+	first, _ := utf8.DecodeRuneInString(currency)
+	last, _ := utf8.DecodeLastRuneInString(currency)
+	if unicode.IsLetter(last) {
+		pattern = strings.Replace(pattern, "$n", "$\u00a0n", 1)
+	}
+	if unicode.IsLetter(first) {
+		pattern = strings.Replace(pattern, "n$", "n\u00a0$", 1)
+	}
+	return pattern
+}
+
+// applyWidth replaces the placeholders of pattern with r and appends
+// suffix, padded to opts.Width (see FormatOptions.Width). point is the
+// offset of the alignment point in the number, which is returned as an
+// offset in the result.
+func applyWidth(pattern string, r *strings.Replacer, suffix string, point int, negative bool, opts FormatOptions) (string, int) {
+	if opts.Width > 0 {
+		if opts.Accounting && !negative {
+			pattern += " "
+		}
+		if pad := opts.Width - utf8.RuneCountInString(r.Replace(pattern)+suffix); pad > 0 {
+			pattern = padPattern(pattern, pad)
+		}
+	}
+	prefix := r.Replace(pattern[:strings.IndexByte(pattern, 'n')])
+	return r.Replace(pattern) + suffix, len(prefix) + point
+}
+
+// padPattern inserts pad spaces into pattern: after the currency and its
+// spacing if the currency comes before the number, e.g. "$ n" becomes
+// "$    n", and in front of the pattern otherwise.
+func padPattern(pattern string, pad int) string {
+	spaces := strings.Repeat(" ", pad)
+	c, n := strings.IndexByte(pattern, '$'), strings.IndexByte(pattern, 'n')
+	if c < 0 || c > n {
+		return spaces + pattern
+	}
+	i := c + 1
+	for {
+		if strings.HasPrefix(pattern[i:], " ") {
+			i++
+		} else if strings.HasPrefix(pattern[i:], "\u00a0") {
+			i += len("\u00a0")
+		} else {
+			break
+		}
+	}
+	return pattern[:i] + spaces + pattern[i:]
+}
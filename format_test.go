@@ -0,0 +1,75 @@
+package i18n
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMoneyFormatWith(t *testing.T) {
+	tests := []struct {
+		m        Money
+		locale   string
+		opts     FormatOptions
+		expected string
+	}{
+		{Money{123456, "USD"}, "en_US", FormatOptions{}, "$1,234.56"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{Display: DisplayCode}, "USD\u00a01,234.56"},
+		{Money{-123456, "USD"}, "en_US", FormatOptions{Display: DisplayCode}, "(USD\u00a01,234.56)"},
+		{Money{123456, "USD"}, "de_DE", FormatOptions{Display: DisplayCode}, "1.234,56 USD"},
+		{Money{-123456, "CHF"}, "de_CH", FormatOptions{Display: DisplayCode}, "CHF-1'234.56"},
+		{Money{123456, "HKD"}, "en_US", FormatOptions{}, "HK$1,234.56"},
+		{Money{123456, "HKD"}, "en_US", FormatOptions{Display: DisplayNarrowSymbol}, "$1,234.56"},
+		{Money{123456, "BOB"}, "en_US", FormatOptions{Display: DisplayNarrowSymbol}, "Bs\u00a01,234.56"},
+		{Money{123456, "EUR"}, "en_US", FormatOptions{Display: DisplayNarrowSymbol}, "€1,234.56"},
+		{Money{123456, "CHF"}, "de_CH", FormatOptions{Display: DisplayLocaleSymbol}, "Fr. 1'234.56"},
+		{Money{123456, "EUR"}, "de_CH", FormatOptions{Display: DisplayLocaleSymbol}, "€ 1'234.56"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{Display: DisplayName}, "1,234.56 US dollars"},
+		{Money{100, "USD"}, "en_US", FormatOptions{Display: DisplayName}, "1.00 US dollars"},
+		{Money{100, "USD"}, "en_US", FormatOptions{Display: DisplayName, MinorUnits: MinorUnitsOmitZero}, "1 US dollar"},
+		{Money{-123456, "USD"}, "en_US", FormatOptions{Display: DisplayName}, "-1,234.56 US dollars"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{Display: DisplayName, PlusSign: true}, "+1,234.56 US dollars"},
+		{Money{150, "EUR"}, "fr_FR", FormatOptions{Display: DisplayName}, "1,50 euro"},
+		{Money{250, "EUR"}, "fr_FR", FormatOptions{Display: DisplayName}, "2,50 euros"},
+		{Money{100, "USD"}, "es_ES", FormatOptions{Display: DisplayName}, "1,00 dólar estadounidense"},
+		{Money{123456, "EUR"}, "de_DE", FormatOptions{Display: DisplayName}, "1.234,56 Euro"},
+		{Money{123456, "XAU"}, "de_DE", FormatOptions{Display: DisplayName}, "1.234,56 Gold"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{PlusSign: true}, "+$1,234.56"},
+		{Money{0, "USD"}, "en_US", FormatOptions{PlusSign: true}, "$0.00"},
+		{Money{123456, "EUR"}, "de_DE", FormatOptions{PlusSign: true}, "+1.234,56 €"},
+		{Money{123456, "EUR"}, "de_CH", FormatOptions{PlusSign: true}, "€+1'234.56"},
+		{Money{123400, "USD"}, "en_US", FormatOptions{MinorUnits: MinorUnitsOmitZero}, "$1,234"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{MinorUnits: MinorUnitsOmitZero}, "$1,234.56"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{MinorUnits: MinorUnitsNever}, "$1,235"},
+		{Money{123450, "USD"}, "en_US", FormatOptions{MinorUnits: MinorUnitsNever, Rounding: RoundHalfEven}, "$1,234"},
+		{Money{-40, "USD"}, "en_US", FormatOptions{MinorUnits: MinorUnitsNever}, "$0"},
+		{Money{123456, "JPY"}, "en_US", FormatOptions{MinorUnits: MinorUnitsNever}, "¥123,456"},
+		{Money{123456, "EUR"}, "xx_XX", FormatOptions{Display: DisplayCode}, "1234.56 EUR"},
+		{Money{-123456, "EUR"}, "de_DE", FormatOptions{Accounting: true}, "(1.234,56 €)"},
+		{Money{-123456, "USD"}, "en_US", FormatOptions{Accounting: true}, "($1,234.56)"},
+		{Money{-123456, "CHF"}, "de_CH", FormatOptions{Accounting: true, Display: DisplayCode}, "(CHF 1'234.56)"},
+		{Money{-123456, "USD"}, "en_US", FormatOptions{Accounting: true, Display: DisplayName}, "(1,234.56) US dollars"},
+		{Money{123456, "EUR"}, "de_DE", FormatOptions{Accounting: true}, "1.234,56 €"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{Width: 12}, "$   1,234.56"},
+		{Money{-123456, "EUR"}, "de_DE", FormatOptions{Width: 12}, " -1.234,56 €"},
+		{Money{123456, "EUR"}, "de_CH", FormatOptions{Width: 12}, "€   1'234.56"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{Width: 14, Display: DisplayCode}, "USD\u00a0  1,234.56"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{Width: 12, Accounting: true}, "$  1,234.56 "},
+		{Money{-123456, "USD"}, "en_US", FormatOptions{Width: 12, Accounting: true}, "($ 1,234.56)"},
+		{Money{123456, "EUR"}, "de_DE", FormatOptions{Width: 12, Accounting: true}, " 1.234,56 € "},
+		{Money{-123456, "EUR"}, "de_DE", FormatOptions{Width: 12, Accounting: true}, "(1.234,56 €)"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{Width: 22, Display: DisplayName}, "   1,234.56 US dollars"},
+		{Money{123456, "USD"}, "en_US", FormatOptions{Width: 4}, "$1,234.56"},
+		{Money{1249, "SEK"}, "sv_SE", FormatOptions{Cash: true}, "12 kr"},
+		{Money{123456789, "SEK"}, "sv_SE", FormatOptions{Cash: true}, "1.234.568 kr"},
+		{Money{-1225, "DKK"}, "da_DK", FormatOptions{Cash: true}, "kr. -12,50"},
+		{Money{1237, "CHF"}, "de_CH", FormatOptions{Cash: true}, "fr. 12.35"},
+		{Money{1234, "NZD"}, "en_NZ", FormatOptions{Cash: true}, "$12.30"},
+		{Money{1237, "EUR"}, "de_DE", FormatOptions{Cash: true}, "12,37 €"},
+		{Money{math.MaxInt64, "SEK"}, "sv_SE", FormatOptions{Cash: true}, "92.233.720.368.547.758 kr"},
+	}
+	for _, test := range tests {
+		if got := test.m.FormatWith(test.locale, test.opts); got != test.expected {
+			t.Errorf("%v %s %+v: expected %q, got %q", test.m, test.locale, test.opts, test.expected, got)
+		}
+	}
+}
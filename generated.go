@@ -17,6 +17,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -42,6 +43,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            "፣",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NAN",
@@ -67,6 +69,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -92,6 +95,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -117,6 +121,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -142,6 +147,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -167,6 +173,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -192,6 +199,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -217,6 +225,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -242,6 +251,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -267,6 +277,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -292,6 +303,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -317,6 +329,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -342,6 +355,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -367,6 +381,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -392,6 +407,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -417,6 +433,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -442,6 +459,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
@@ -467,6 +485,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -492,6 +511,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "nan",
@@ -517,6 +537,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -542,6 +563,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -567,6 +589,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -592,6 +615,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -617,6 +641,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -642,6 +667,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -667,6 +693,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -692,6 +719,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ཨང་ཀི་མིན་པ།",
@@ -717,6 +745,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NkN",
@@ -742,6 +771,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -767,6 +797,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -792,6 +823,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -817,6 +849,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Mica numericu",
@@ -842,6 +875,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Není číslo",
@@ -867,6 +901,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -892,6 +927,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -917,6 +953,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. def.",
@@ -942,6 +979,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. def.",
@@ -967,6 +1005,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. def.",
@@ -992,6 +1031,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. def.",
@@ -1017,6 +1057,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. def.",
@@ -1042,6 +1083,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "njedefinowane",
@@ -1067,6 +1109,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            "،",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1092,6 +1135,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "μη αριθμός",
@@ -1117,6 +1161,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1142,6 +1187,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1167,6 +1213,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1192,6 +1239,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1217,6 +1265,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1242,6 +1291,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1267,6 +1317,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1292,6 +1343,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1317,6 +1369,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1342,6 +1395,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1367,6 +1421,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1392,6 +1447,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1417,6 +1473,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1442,6 +1499,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1467,6 +1525,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1492,6 +1551,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -1517,6 +1577,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1542,6 +1603,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1567,6 +1629,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1592,6 +1655,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1617,6 +1681,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1642,6 +1707,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1667,6 +1733,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1692,6 +1759,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1717,6 +1785,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1742,6 +1811,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1767,6 +1837,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1792,6 +1863,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1817,6 +1889,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1842,6 +1915,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1867,6 +1941,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1892,6 +1967,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1917,6 +1993,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1942,6 +2019,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1967,6 +2045,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -1992,6 +2071,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -2017,6 +2097,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "avaldamatu",
@@ -2042,6 +2123,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "% n",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "EdZ",
@@ -2067,6 +2149,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2092,6 +2175,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2117,6 +2201,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2142,6 +2227,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2167,6 +2253,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
@@ -2192,6 +2279,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
@@ -2217,6 +2305,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
@@ -2242,6 +2331,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
@@ -2267,6 +2357,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
@@ -2292,6 +2383,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
@@ -2317,6 +2409,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2342,6 +2435,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2367,6 +2461,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2392,6 +2487,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -2417,6 +2513,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Ohne Nummer",
@@ -2442,6 +2539,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2467,6 +2565,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2492,6 +2591,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "לא מספר",
@@ -2517,6 +2617,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2542,6 +2643,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2567,6 +2669,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2592,6 +2695,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "njedefinowane",
@@ -2617,6 +2721,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "nem szám",
@@ -2642,6 +2747,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2667,6 +2773,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2692,6 +2799,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2717,6 +2825,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ꌗꂷꀋꉬ",
@@ -2742,6 +2851,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2767,6 +2877,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non un numero reale",
@@ -2792,6 +2903,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non un numero reale",
@@ -2817,6 +2929,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2842,6 +2955,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2867,6 +2981,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN (非数値)",
@@ -2892,6 +3007,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2917,6 +3033,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2942,6 +3059,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -2967,6 +3085,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NAN",
@@ -2992,6 +3111,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3017,6 +3137,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3042,6 +3163,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3067,6 +3189,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3092,6 +3215,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. num.",
@@ -3117,6 +3241,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3142,6 +3267,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3167,6 +3293,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3192,6 +3319,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3217,6 +3345,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3242,6 +3371,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3267,6 +3397,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3292,6 +3423,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ᠲᠤᠭᠠᠠ ᠪᠤᠰᠤ",
@@ -3317,6 +3449,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3342,6 +3475,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3367,6 +3501,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3392,6 +3527,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3417,6 +3553,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3442,6 +3579,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3467,6 +3605,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "nan",
@@ -3492,6 +3631,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN (Niet-een-getal)",
@@ -3517,6 +3657,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3542,6 +3683,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3567,6 +3709,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3592,6 +3735,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numeric",
@@ -3617,6 +3761,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3642,6 +3787,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3667,6 +3813,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "nie jest liczbą",
@@ -3692,6 +3839,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "غ ع",
@@ -3717,6 +3865,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "غ ع",
@@ -3742,6 +3891,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN (Não é um número)",
@@ -3767,6 +3917,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN (Não é um número)",
@@ -3792,6 +3943,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
@@ -3817,6 +3969,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3842,6 +3995,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3867,6 +4021,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3892,6 +4047,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "betg def.",
@@ -3917,6 +4073,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3942,6 +4099,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3967,6 +4125,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -3992,6 +4151,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4017,6 +4177,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NAN",
@@ -4042,6 +4203,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4067,6 +4229,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4092,6 +4255,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4117,6 +4281,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4142,6 +4307,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Nie je číslo",
@@ -4167,6 +4333,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4192,6 +4359,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4217,6 +4385,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4242,6 +4411,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4267,6 +4437,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4292,6 +4463,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4317,6 +4489,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4342,6 +4515,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4367,6 +4541,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4392,6 +4567,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4417,6 +4593,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4442,6 +4619,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4467,6 +4645,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4492,6 +4671,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4517,6 +4697,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4542,6 +4723,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4567,6 +4749,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4592,6 +4775,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4617,6 +4801,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4642,6 +4827,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4667,6 +4853,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4692,6 +4879,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4717,6 +4905,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4742,6 +4931,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4767,6 +4957,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4792,6 +4983,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4817,6 +5009,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "%n",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4842,6 +5035,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n %",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4867,6 +5061,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
@@ -4892,6 +5087,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "سان ئەمەس",
@@ -4917,6 +5113,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4942,6 +5139,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4967,6 +5165,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -4992,6 +5191,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -5017,6 +5217,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -5042,6 +5243,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
@@ -5067,6 +5269,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -5092,6 +5295,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -5117,6 +5321,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "非数字",
@@ -5142,6 +5347,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "非數字",
@@ -5167,6 +5373,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "非數字",
@@ -5192,6 +5399,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -5217,6 +5425,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "不是一個數字",
@@ -5242,6 +5451,7 @@ var Locales = map[string]*Locale{
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
+		PercentPattern:           "n%",
 		PercentSymbol:            "%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
@@ -5340,14 +5550,16 @@ var Languages = map[string]*Language{
 		EnglishName: "Welsh",
 	},
 	"da": &Language{
-		Code:        "da",
-		NativeName:  "dansk",
-		EnglishName: "Danish",
+		Code:            "da",
+		NativeName:      "dansk",
+		EnglishName:     "Danish",
+		CompactSuffixes: []string{"\u00a0t", "\u00a0mio.", "\u00a0mia.", "\u00a0bio."},
 	},
 	"de": &Language{
-		Code:        "de",
-		NativeName:  "Deutsch",
-		EnglishName: "German",
+		Code:            "de",
+		NativeName:      "Deutsch",
+		EnglishName:     "German",
+		CompactSuffixes: []string{"\u00a0Tsd.", "\u00a0Mio.", "\u00a0Mrd.", "\u00a0Bio."},
 	},
 	"dsb": &Language{
 		Code:        "dsb",
@@ -5365,14 +5577,16 @@ var Languages = map[string]*Language{
 		EnglishName: "Greek",
 	},
 	"en": &Language{
-		Code:        "en",
-		NativeName:  "English",
-		EnglishName: "English",
+		Code:            "en",
+		NativeName:      "English",
+		EnglishName:     "English",
+		CompactSuffixes: []string{"K", "M", "B", "T"},
 	},
 	"es": &Language{
-		Code:        "es",
-		NativeName:  "español",
-		EnglishName: "Spanish",
+		Code:            "es",
+		NativeName:      "español",
+		EnglishName:     "Spanish",
+		CompactSuffixes: []string{"\u00a0mil", "\u00a0M", "\u00a0mil\u00a0M", "\u00a0B"},
 	},
 	"et": &Language{
 		Code:        "et",
@@ -5405,9 +5619,10 @@ var Languages = map[string]*Language{
 		EnglishName: "Faroese",
 	},
 	"fr": &Language{
-		Code:        "fr",
-		NativeName:  "français",
-		EnglishName: "French",
+		Code:            "fr",
+		NativeName:      "français",
+		EnglishName:     "French",
+		CompactSuffixes: []string{"\u00a0k", "\u00a0M", "\u00a0Md", "\u00a0Bn"},
 	},
 	"fy": &Language{
 		Code:        "fy",
@@ -5495,9 +5710,10 @@ var Languages = map[string]*Language{
 		EnglishName: "Icelandic",
 	},
 	"it": &Language{
-		Code:        "it",
-		NativeName:  "italiano",
-		EnglishName: "Italian",
+		Code:            "it",
+		NativeName:      "italiano",
+		EnglishName:     "Italian",
+		CompactSuffixes: []string{"", "\u00a0Mln", "\u00a0Mrd", "\u00a0Bln"},
 	},
 	"iu": &Language{
 		Code:        "iu",
@@ -5625,9 +5841,10 @@ var Languages = map[string]*Language{
 		EnglishName: "Nepali",
 	},
 	"nl": &Language{
-		Code:        "nl",
-		NativeName:  "Nederlands",
-		EnglishName: "Dutch",
+		Code:            "nl",
+		NativeName:      "Nederlands",
+		EnglishName:     "Dutch",
+		CompactSuffixes: []string{"K", "\u00a0mln.", "\u00a0mld.", "\u00a0bln."},
 	},
 	"nn": &Language{
 		Code:        "nn",
@@ -5655,9 +5872,10 @@ var Languages = map[string]*Language{
 		EnglishName: "Punjabi",
 	},
 	"pl": &Language{
-		Code:        "pl",
-		NativeName:  "polski",
-		EnglishName: "Polish",
+		Code:            "pl",
+		NativeName:      "polski",
+		EnglishName:     "Polish",
+		CompactSuffixes: []string{"\u00a0tys.", "\u00a0mln", "\u00a0mld", "\u00a0bln"},
 	},
 	"prs": &Language{
 		Code:        "prs",
@@ -5670,9 +5888,10 @@ var Languages = map[string]*Language{
 		EnglishName: "Pashto",
 	},
 	"pt": &Language{
-		Code:        "pt",
-		NativeName:  "Português",
-		EnglishName: "Portuguese",
+		Code:            "pt",
+		NativeName:      "Português",
+		EnglishName:     "Portuguese",
+		CompactSuffixes: []string{"\u00a0mil", "\u00a0mi", "\u00a0bi", "\u00a0tri"},
 	},
 	"qut": &Language{
 		Code:        "qut",
@@ -5695,9 +5914,10 @@ var Languages = map[string]*Language{
 		EnglishName: "Romanian",
 	},
 	"ru": &Language{
-		Code:        "ru",
-		NativeName:  "русский",
-		EnglishName: "Russian",
+		Code:            "ru",
+		NativeName:      "русский",
+		EnglishName:     "Russian",
+		CompactSuffixes: []string{"\u00a0тыс.", "\u00a0млн", "\u00a0млрд", "\u00a0трлн"},
 	},
 	"rw": &Language{
 		Code:        "rw",
@@ -5765,9 +5985,10 @@ var Languages = map[string]*Language{
 		EnglishName: "Serbian (Cyrillic)",
 	},
 	"sv": &Language{
-		Code:        "sv",
-		NativeName:  "svenska",
-		EnglishName: "Swedish",
+		Code:            "sv",
+		NativeName:      "svenska",
+		EnglishName:     "Swedish",
+		CompactSuffixes: []string{"\u00a0tn", "\u00a0mn", "\u00a0md", "\u00a0bn"},
 	},
 	"sw": &Language{
 		Code:        "sw",
@@ -6519,39 +6740,68 @@ const (
 	AFN CurrencyCode = "AFN"
 	ALL CurrencyCode = "ALL"
 	AMD CurrencyCode = "AMD"
+	ANG CurrencyCode = "ANG"
+	AOA CurrencyCode = "AOA"
 	ARS CurrencyCode = "ARS"
 	AUD CurrencyCode = "AUD"
+	AWG CurrencyCode = "AWG"
 	AZN CurrencyCode = "AZN"
 	BAM CurrencyCode = "BAM"
+	BBD CurrencyCode = "BBD"
 	BDT CurrencyCode = "BDT"
 	BGN CurrencyCode = "BGN"
 	BHD CurrencyCode = "BHD"
+	BIF CurrencyCode = "BIF"
+	BMD CurrencyCode = "BMD"
 	BND CurrencyCode = "BND"
 	BOB CurrencyCode = "BOB"
+	BOV CurrencyCode = "BOV"
 	BRL CurrencyCode = "BRL"
+	BSD CurrencyCode = "BSD"
+	BTN CurrencyCode = "BTN"
+	BWP CurrencyCode = "BWP"
+	BYN CurrencyCode = "BYN"
 	BYR CurrencyCode = "BYR"
 	BZD CurrencyCode = "BZD"
 	CAD CurrencyCode = "CAD"
+	CDF CurrencyCode = "CDF"
+	CHE CurrencyCode = "CHE"
 	CHF CurrencyCode = "CHF"
+	CHW CurrencyCode = "CHW"
+	CLF CurrencyCode = "CLF"
 	CLP CurrencyCode = "CLP"
 	CNY CurrencyCode = "CNY"
 	COP CurrencyCode = "COP"
+	COU CurrencyCode = "COU"
 	CRC CurrencyCode = "CRC"
 	CSD CurrencyCode = "CSD"
+	CUC CurrencyCode = "CUC"
+	CUP CurrencyCode = "CUP"
+	CVE CurrencyCode = "CVE"
 	CZK CurrencyCode = "CZK"
+	DJF CurrencyCode = "DJF"
 	DKK CurrencyCode = "DKK"
 	DOP CurrencyCode = "DOP"
 	DZD CurrencyCode = "DZD"
 	EEK CurrencyCode = "EEK"
 	EGP CurrencyCode = "EGP"
+	ERN CurrencyCode = "ERN"
 	ETB CurrencyCode = "ETB"
 	EUR CurrencyCode = "EUR"
+	FJD CurrencyCode = "FJD"
+	FKP CurrencyCode = "FKP"
 	GBP CurrencyCode = "GBP"
 	GEL CurrencyCode = "GEL"
+	GHS CurrencyCode = "GHS"
+	GIP CurrencyCode = "GIP"
+	GMD CurrencyCode = "GMD"
+	GNF CurrencyCode = "GNF"
 	GTQ CurrencyCode = "GTQ"
+	GYD CurrencyCode = "GYD"
 	HKD CurrencyCode = "HKD"
 	HNL CurrencyCode = "HNL"
 	HRK CurrencyCode = "HRK"
+	HTG CurrencyCode = "HTG"
 	HUF CurrencyCode = "HUF"
 	IDR CurrencyCode = "IDR"
 	ILS CurrencyCode = "ILS"
@@ -6565,22 +6815,37 @@ const (
 	KES CurrencyCode = "KES"
 	KGS CurrencyCode = "KGS"
 	KHR CurrencyCode = "KHR"
+	KMF CurrencyCode = "KMF"
+	KPW CurrencyCode = "KPW"
 	KRW CurrencyCode = "KRW"
 	KWD CurrencyCode = "KWD"
+	KYD CurrencyCode = "KYD"
 	KZT CurrencyCode = "KZT"
 	LAK CurrencyCode = "LAK"
 	LBP CurrencyCode = "LBP"
 	LKR CurrencyCode = "LKR"
+	LRD CurrencyCode = "LRD"
+	LSL CurrencyCode = "LSL"
 	LTL CurrencyCode = "LTL"
 	LVL CurrencyCode = "LVL"
 	LYD CurrencyCode = "LYD"
 	MAD CurrencyCode = "MAD"
+	MDL CurrencyCode = "MDL"
+	MGA CurrencyCode = "MGA"
 	MKD CurrencyCode = "MKD"
+	MMK CurrencyCode = "MMK"
 	MNT CurrencyCode = "MNT"
 	MOP CurrencyCode = "MOP"
+	MRU CurrencyCode = "MRU"
+	MUR CurrencyCode = "MUR"
 	MVR CurrencyCode = "MVR"
+	MWK CurrencyCode = "MWK"
 	MXN CurrencyCode = "MXN"
+	MXV CurrencyCode = "MXV"
 	MYR CurrencyCode = "MYR"
+	MZN CurrencyCode = "MZN"
+	NAD CurrencyCode = "NAD"
+	NGN CurrencyCode = "NGN"
 	NIO CurrencyCode = "NIO"
 	NOK CurrencyCode = "NOK"
 	NPR CurrencyCode = "NPR"
@@ -6588,6 +6853,7 @@ const (
 	OMR CurrencyCode = "OMR"
 	PAB CurrencyCode = "PAB"
 	PEN CurrencyCode = "PEN"
+	PGK CurrencyCode = "PGK"
 	PHP CurrencyCode = "PHP"
 	PKR CurrencyCode = "PKR"
 	PLN CurrencyCode = "PLN"
@@ -6598,130 +6864,255 @@ const (
 	RUB CurrencyCode = "RUB"
 	RWF CurrencyCode = "RWF"
 	SAR CurrencyCode = "SAR"
+	SBD CurrencyCode = "SBD"
+	SCR CurrencyCode = "SCR"
+	SDG CurrencyCode = "SDG"
 	SEK CurrencyCode = "SEK"
 	SGD CurrencyCode = "SGD"
+	SHP CurrencyCode = "SHP"
+	SLE CurrencyCode = "SLE"
+	SLL CurrencyCode = "SLL"
+	SOS CurrencyCode = "SOS"
+	SRD CurrencyCode = "SRD"
+	SSP CurrencyCode = "SSP"
+	STN CurrencyCode = "STN"
+	SVC CurrencyCode = "SVC"
 	SYP CurrencyCode = "SYP"
+	SZL CurrencyCode = "SZL"
 	THB CurrencyCode = "THB"
 	TJS CurrencyCode = "TJS"
 	TMT CurrencyCode = "TMT"
 	TND CurrencyCode = "TND"
+	TOP CurrencyCode = "TOP"
 	TRY CurrencyCode = "TRY"
 	TTD CurrencyCode = "TTD"
 	TWD CurrencyCode = "TWD"
+	TZS CurrencyCode = "TZS"
 	UAH CurrencyCode = "UAH"
+	UGX CurrencyCode = "UGX"
 	USD CurrencyCode = "USD"
+	USN CurrencyCode = "USN"
+	UYI CurrencyCode = "UYI"
 	UYU CurrencyCode = "UYU"
+	UYW CurrencyCode = "UYW"
 	UZS CurrencyCode = "UZS"
+	VED CurrencyCode = "VED"
 	VEF CurrencyCode = "VEF"
+	VES CurrencyCode = "VES"
 	VND CurrencyCode = "VND"
+	VUV CurrencyCode = "VUV"
+	WST CurrencyCode = "WST"
+	XAF CurrencyCode = "XAF"
+	XAG CurrencyCode = "XAG"
+	XAU CurrencyCode = "XAU"
+	XBA CurrencyCode = "XBA"
+	XBB CurrencyCode = "XBB"
+	XBC CurrencyCode = "XBC"
+	XBD CurrencyCode = "XBD"
+	XCD CurrencyCode = "XCD"
+	XDR CurrencyCode = "XDR"
 	XOF CurrencyCode = "XOF"
+	XPD CurrencyCode = "XPD"
+	XPF CurrencyCode = "XPF"
+	XPT CurrencyCode = "XPT"
+	XSU CurrencyCode = "XSU"
+	XTS CurrencyCode = "XTS"
+	XUA CurrencyCode = "XUA"
+	XXX CurrencyCode = "XXX"
 	YER CurrencyCode = "YER"
 	ZAR CurrencyCode = "ZAR"
+	ZMW CurrencyCode = "ZMW"
+	ZWG CurrencyCode = "ZWG"
 	ZWL CurrencyCode = "ZWL"
 )
 
 var Currencies = map[CurrencyCode]*Currency{
-	AED: &Currency{Code: "AED", Symbol: "د.إ.‏"},
-	AFN: &Currency{Code: "AFN", Symbol: "؋"},
-	ALL: &Currency{Code: "ALL", Symbol: "Lek"},
-	AMD: &Currency{Code: "AMD", Symbol: "դր."},
-	ARS: &Currency{Code: "ARS", Symbol: "$"},
-	AUD: &Currency{Code: "AUD", Symbol: "$"},
-	AZN: &Currency{Code: "AZN", Symbol: "man."},
-	BAM: &Currency{Code: "BAM", Symbol: "KM"},
-	BDT: &Currency{Code: "BDT", Symbol: "৳"},
-	BGN: &Currency{Code: "BGN", Symbol: "лв."},
-	BHD: &Currency{Code: "BHD", Symbol: "د.ب.‏"},
-	BND: &Currency{Code: "BND", Symbol: "$"},
-	BOB: &Currency{Code: "BOB", Symbol: "$b"},
-	BRL: &Currency{Code: "BRL", Symbol: "R$"},
-	BYR: &Currency{Code: "BYR", Symbol: "р."},
-	BZD: &Currency{Code: "BZD", Symbol: "BZ$"},
-	CAD: &Currency{Code: "CAD", Symbol: "$"},
-	CHF: &Currency{Code: "CHF", Symbol: "fr."},
-	CLP: &Currency{Code: "CLP", Symbol: "$"},
-	CNY: &Currency{Code: "CNY", Symbol: "¥"},
-	COP: &Currency{Code: "COP", Symbol: "$"},
-	CRC: &Currency{Code: "CRC", Symbol: "₡"},
-	CSD: &Currency{Code: "CSD", Symbol: "Din."},
-	CZK: &Currency{Code: "CZK", Symbol: "Kč"},
-	DKK: &Currency{Code: "DKK", Symbol: "kr."},
-	DOP: &Currency{Code: "DOP", Symbol: "RD$"},
-	DZD: &Currency{Code: "DZD", Symbol: "DZD"},
-	EEK: &Currency{Code: "EEK", Symbol: "kr"},
-	EGP: &Currency{Code: "EGP", Symbol: "ج.م.‏"},
-	ETB: &Currency{Code: "ETB", Symbol: "ETB"},
-	EUR: &Currency{Code: "EUR", Symbol: "€"},
-	GBP: &Currency{Code: "GBP", Symbol: "£"},
-	GEL: &Currency{Code: "GEL", Symbol: "Lari"},
-	GTQ: &Currency{Code: "GTQ", Symbol: "Q"},
-	HKD: &Currency{Code: "HKD", Symbol: "HK$"},
-	HNL: &Currency{Code: "HNL", Symbol: "L."},
-	HRK: &Currency{Code: "HRK", Symbol: "kn"},
-	HUF: &Currency{Code: "HUF", Symbol: "Ft"},
-	IDR: &Currency{Code: "IDR", Symbol: "Rp"},
-	ILS: &Currency{Code: "ILS", Symbol: "₪"},
-	INR: &Currency{Code: "INR", Symbol: "रु"},
-	IQD: &Currency{Code: "IQD", Symbol: "د.ع.‏"},
-	IRR: &Currency{Code: "IRR", Symbol: "ريال"},
-	ISK: &Currency{Code: "ISK", Symbol: "kr."},
-	JMD: &Currency{Code: "JMD", Symbol: "J$"},
-	JOD: &Currency{Code: "JOD", Symbol: "د.ا.‏"},
-	JPY: &Currency{Code: "JPY", Symbol: "¥"},
-	KES: &Currency{Code: "KES", Symbol: "S"},
-	KGS: &Currency{Code: "KGS", Symbol: "сом"},
-	KHR: &Currency{Code: "KHR", Symbol: "៛"},
-	KRW: &Currency{Code: "KRW", Symbol: "₩"},
-	KWD: &Currency{Code: "KWD", Symbol: "د.ك.‏"},
-	KZT: &Currency{Code: "KZT", Symbol: "Т"},
-	LAK: &Currency{Code: "LAK", Symbol: "₭"},
-	LBP: &Currency{Code: "LBP", Symbol: "ل.ل.‏"},
-	LKR: &Currency{Code: "LKR", Symbol: "රු."},
-	LTL: &Currency{Code: "LTL", Symbol: "Lt"},
-	LVL: &Currency{Code: "LVL", Symbol: "Ls"},
-	LYD: &Currency{Code: "LYD", Symbol: "د.ل.‏"},
-	MAD: &Currency{Code: "MAD", Symbol: "د.م.‏"},
-	MKD: &Currency{Code: "MKD", Symbol: "ден."},
-	MNT: &Currency{Code: "MNT", Symbol: "₮"},
-	MOP: &Currency{Code: "MOP", Symbol: "MOP"},
-	MVR: &Currency{Code: "MVR", Symbol: "ރ."},
-	MXN: &Currency{Code: "MXN", Symbol: "$"},
-	MYR: &Currency{Code: "MYR", Symbol: "RM"},
-	NIO: &Currency{Code: "NIO", Symbol: "N"},
-	NOK: &Currency{Code: "NOK", Symbol: "kr"},
-	NPR: &Currency{Code: "NPR", Symbol: "रु"},
-	NZD: &Currency{Code: "NZD", Symbol: "$"},
-	OMR: &Currency{Code: "OMR", Symbol: "ر.ع.‏"},
-	PAB: &Currency{Code: "PAB", Symbol: "B/."},
-	PEN: &Currency{Code: "PEN", Symbol: "S/."},
-	PHP: &Currency{Code: "PHP", Symbol: "PhP"},
-	PKR: &Currency{Code: "PKR", Symbol: "Rs"},
-	PLN: &Currency{Code: "PLN", Symbol: "zł"},
-	PYG: &Currency{Code: "PYG", Symbol: "Gs"},
-	QAR: &Currency{Code: "QAR", Symbol: "ر.ق.‏"},
-	RON: &Currency{Code: "RON", Symbol: "lei"},
-	RSD: &Currency{Code: "RSD", Symbol: "Din."},
-	RUB: &Currency{Code: "RUB", Symbol: "р."},
-	RWF: &Currency{Code: "RWF", Symbol: "RWF"},
-	SAR: &Currency{Code: "SAR", Symbol: "ر.س.‏"},
-	SEK: &Currency{Code: "SEK", Symbol: "kr"},
-	SGD: &Currency{Code: "SGD", Symbol: "$"},
-	SYP: &Currency{Code: "SYP", Symbol: "ل.س.‏"},
-	THB: &Currency{Code: "THB", Symbol: "฿"},
-	TJS: &Currency{Code: "TJS", Symbol: "т.р."},
-	TMT: &Currency{Code: "TMT", Symbol: "m."},
-	TND: &Currency{Code: "TND", Symbol: "د.ت.‏"},
-	TRY: &Currency{Code: "TRY", Symbol: "TL"},
-	TTD: &Currency{Code: "TTD", Symbol: "TT$"},
-	TWD: &Currency{Code: "TWD", Symbol: "NT$"},
-	UAH: &Currency{Code: "UAH", Symbol: "₴"},
-	USD: &Currency{Code: "USD", Symbol: "$"},
-	UYU: &Currency{Code: "UYU", Symbol: "$U"},
-	UZS: &Currency{Code: "UZS", Symbol: "so'm"},
-	VEF: &Currency{Code: "VEF", Symbol: "Bs. F."},
-	VND: &Currency{Code: "VND", Symbol: "₫"},
-	XOF: &Currency{Code: "XOF", Symbol: "XOF"},
-	YER: &Currency{Code: "YER", Symbol: "ر.ي.‏"},
-	ZAR: &Currency{Code: "ZAR", Symbol: "R"},
-	ZWL: &Currency{Code: "ZWL", Symbol: "Z$"},
+	AED: &Currency{Code: "AED", Symbol: "د.إ.‏", Numeric: "784", Digits: 2, Name: "UAE Dirham"},
+	AFN: &Currency{Code: "AFN", Symbol: "؋", Numeric: "971", Digits: 2, Name: "Afghani"},
+	ALL: &Currency{Code: "ALL", Symbol: "Lek", Numeric: "008", Digits: 2, Name: "Lek"},
+	AMD: &Currency{Code: "AMD", Symbol: "դր.", Numeric: "051", Digits: 2, Name: "Armenian Dram"},
+	ANG: &Currency{Code: "ANG", Symbol: "ƒ", Numeric: "532", Digits: 2, Name: "Netherlands Antillean Guilder"},
+	AOA: &Currency{Code: "AOA", Symbol: "AOA", Numeric: "973", Digits: 2, Name: "Kwanza"},
+	ARS: &Currency{Code: "ARS", Symbol: "$", Numeric: "032", Digits: 2, Name: "Argentine Peso"},
+	AUD: &Currency{Code: "AUD", Symbol: "$", Numeric: "036", Digits: 2, Name: "Australian Dollar", CashIncrement: 5, CashDigits: 2},
+	AWG: &Currency{Code: "AWG", Symbol: "ƒ", Numeric: "533", Digits: 2, Name: "Aruban Florin"},
+	AZN: &Currency{Code: "AZN", Symbol: "man.", Numeric: "944", Digits: 2, Name: "Azerbaijan Manat"},
+	BAM: &Currency{Code: "BAM", Symbol: "KM", Numeric: "977", Digits: 2, Name: "Convertible Mark"},
+	BBD: &Currency{Code: "BBD", Symbol: "$", Numeric: "052", Digits: 2, Name: "Barbados Dollar"},
+	BDT: &Currency{Code: "BDT", Symbol: "৳", Numeric: "050", Digits: 2, Name: "Taka"},
+	BGN: &Currency{Code: "BGN", Symbol: "лв.", Numeric: "975", Digits: 2, Name: "Bulgarian Lev"},
+	BHD: &Currency{Code: "BHD", Symbol: "د.ب.‏", Numeric: "048", Digits: 3, Name: "Bahraini Dinar"},
+	BIF: &Currency{Code: "BIF", Symbol: "BIF", Numeric: "108", Digits: 0, Name: "Burundi Franc"},
+	BMD: &Currency{Code: "BMD", Symbol: "$", Numeric: "060", Digits: 2, Name: "Bermudian Dollar"},
+	BND: &Currency{Code: "BND", Symbol: "$", Numeric: "096", Digits: 2, Name: "Brunei Dollar"},
+	BOB: &Currency{Code: "BOB", Symbol: "$b", Numeric: "068", Digits: 2, Name: "Boliviano"},
+	BOV: &Currency{Code: "BOV", Symbol: "BOV", Numeric: "984", Digits: 2, Name: "Mvdol"},
+	BRL: &Currency{Code: "BRL", Symbol: "R$", Numeric: "986", Digits: 2, Name: "Brazilian Real"},
+	BSD: &Currency{Code: "BSD", Symbol: "$", Numeric: "044", Digits: 2, Name: "Bahamian Dollar"},
+	BTN: &Currency{Code: "BTN", Symbol: "BTN", Numeric: "064", Digits: 2, Name: "Ngultrum"},
+	BWP: &Currency{Code: "BWP", Symbol: "BWP", Numeric: "072", Digits: 2, Name: "Pula"},
+	BYN: &Currency{Code: "BYN", Symbol: "Br", Numeric: "933", Digits: 2, Name: "Belarusian Ruble"},
+	BYR: &Currency{Code: "BYR", Symbol: "р.", Numeric: "974", Digits: 0, Name: "Belarusian Ruble (2000-2016)"},
+	BZD: &Currency{Code: "BZD", Symbol: "BZ$", Numeric: "084", Digits: 2, Name: "Belize Dollar"},
+	CAD: &Currency{Code: "CAD", Symbol: "$", Numeric: "124", Digits: 2, Name: "Canadian Dollar", CashIncrement: 5, CashDigits: 2},
+	CDF: &Currency{Code: "CDF", Symbol: "CDF", Numeric: "976", Digits: 2, Name: "Congolese Franc"},
+	CHE: &Currency{Code: "CHE", Symbol: "CHE", Numeric: "947", Digits: 2, Name: "WIR Euro"},
+	CHF: &Currency{Code: "CHF", Symbol: "fr.", Numeric: "756", Digits: 2, Name: "Swiss Franc", CashIncrement: 5, CashDigits: 2},
+	CHW: &Currency{Code: "CHW", Symbol: "CHW", Numeric: "948", Digits: 2, Name: "WIR Franc"},
+	CLF: &Currency{Code: "CLF", Symbol: "CLF", Numeric: "990", Digits: 4, Name: "Unidad de Fomento"},
+	CLP: &Currency{Code: "CLP", Symbol: "$", Numeric: "152", Digits: 0, Name: "Chilean Peso"},
+	CNY: &Currency{Code: "CNY", Symbol: "¥", Numeric: "156", Digits: 2, Name: "Yuan Renminbi"},
+	COP: &Currency{Code: "COP", Symbol: "$", Numeric: "170", Digits: 2, Name: "Colombian Peso"},
+	COU: &Currency{Code: "COU", Symbol: "COU", Numeric: "970", Digits: 2, Name: "Unidad de Valor Real"},
+	CRC: &Currency{Code: "CRC", Symbol: "₡", Numeric: "188", Digits: 2, Name: "Costa Rican Colon"},
+	CSD: &Currency{Code: "CSD", Symbol: "Din.", Numeric: "891", Digits: 2, Name: "Serbian Dinar (2003-2006)"},
+	CUC: &Currency{Code: "CUC", Symbol: "CUC", Numeric: "931", Digits: 2, Name: "Peso Convertible"},
+	CUP: &Currency{Code: "CUP", Symbol: "$", Numeric: "192", Digits: 2, Name: "Cuban Peso"},
+	CVE: &Currency{Code: "CVE", Symbol: "CVE", Numeric: "132", Digits: 2, Name: "Cabo Verde Escudo"},
+	CZK: &Currency{Code: "CZK", Symbol: "Kč", Numeric: "203", Digits: 2, Name: "Czech Koruna", CashIncrement: 100, CashDigits: 0},
+	DJF: &Currency{Code: "DJF", Symbol: "DJF", Numeric: "262", Digits: 0, Name: "Djibouti Franc"},
+	DKK: &Currency{Code: "DKK", Symbol: "kr.", Numeric: "208", Digits: 2, Name: "Danish Krone", CashIncrement: 50, CashDigits: 2},
+	DOP: &Currency{Code: "DOP", Symbol: "RD$", Numeric: "214", Digits: 2, Name: "Dominican Peso"},
+	DZD: &Currency{Code: "DZD", Symbol: "DZD", Numeric: "012", Digits: 2, Name: "Algerian Dinar"},
+	EEK: &Currency{Code: "EEK", Symbol: "kr", Numeric: "233", Digits: 2, Name: "Kroon"},
+	EGP: &Currency{Code: "EGP", Symbol: "ج.م.‏", Numeric: "818", Digits: 2, Name: "Egyptian Pound"},
+	ERN: &Currency{Code: "ERN", Symbol: "ERN", Numeric: "232", Digits: 2, Name: "Nakfa"},
+	ETB: &Currency{Code: "ETB", Symbol: "ETB", Numeric: "230", Digits: 2, Name: "Ethiopian Birr"},
+	EUR: &Currency{Code: "EUR", Symbol: "€", Numeric: "978", Digits: 2, Name: "Euro"},
+	FJD: &Currency{Code: "FJD", Symbol: "$", Numeric: "242", Digits: 2, Name: "Fiji Dollar"},
+	FKP: &Currency{Code: "FKP", Symbol: "£", Numeric: "238", Digits: 2, Name: "Falkland Islands Pound"},
+	GBP: &Currency{Code: "GBP", Symbol: "£", Numeric: "826", Digits: 2, Name: "Pound Sterling"},
+	GEL: &Currency{Code: "GEL", Symbol: "Lari", Numeric: "981", Digits: 2, Name: "Lari"},
+	GHS: &Currency{Code: "GHS", Symbol: "GH₵", Numeric: "936", Digits: 2, Name: "Ghana Cedi"},
+	GIP: &Currency{Code: "GIP", Symbol: "£", Numeric: "292", Digits: 2, Name: "Gibraltar Pound"},
+	GMD: &Currency{Code: "GMD", Symbol: "GMD", Numeric: "270", Digits: 2, Name: "Dalasi"},
+	GNF: &Currency{Code: "GNF", Symbol: "GNF", Numeric: "324", Digits: 0, Name: "Guinean Franc"},
+	GTQ: &Currency{Code: "GTQ", Symbol: "Q", Numeric: "320", Digits: 2, Name: "Quetzal"},
+	GYD: &Currency{Code: "GYD", Symbol: "$", Numeric: "328", Digits: 2, Name: "Guyana Dollar"},
+	HKD: &Currency{Code: "HKD", Symbol: "HK$", Numeric: "344", Digits: 2, Name: "Hong Kong Dollar"},
+	HNL: &Currency{Code: "HNL", Symbol: "L.", Numeric: "340", Digits: 2, Name: "Lempira"},
+	HRK: &Currency{Code: "HRK", Symbol: "kn", Numeric: "191", Digits: 2, Name: "Kuna"},
+	HTG: &Currency{Code: "HTG", Symbol: "HTG", Numeric: "332", Digits: 2, Name: "Gourde"},
+	HUF: &Currency{Code: "HUF", Symbol: "Ft", Numeric: "348", Digits: 2, Name: "Forint", CashIncrement: 100, CashDigits: 0},
+	IDR: &Currency{Code: "IDR", Symbol: "Rp", Numeric: "360", Digits: 2, Name: "Rupiah"},
+	ILS: &Currency{Code: "ILS", Symbol: "₪", Numeric: "376", Digits: 2, Name: "New Israeli Sheqel"},
+	INR: &Currency{Code: "INR", Symbol: "रु", Numeric: "356", Digits: 2, Name: "Indian Rupee"},
+	IQD: &Currency{Code: "IQD", Symbol: "د.ع.‏", Numeric: "368", Digits: 3, Name: "Iraqi Dinar"},
+	IRR: &Currency{Code: "IRR", Symbol: "ريال", Numeric: "364", Digits: 2, Name: "Iranian Rial"},
+	ISK: &Currency{Code: "ISK", Symbol: "kr.", Numeric: "352", Digits: 0, Name: "Iceland Krona"},
+	JMD: &Currency{Code: "JMD", Symbol: "J$", Numeric: "388", Digits: 2, Name: "Jamaican Dollar"},
+	JOD: &Currency{Code: "JOD", Symbol: "د.ا.‏", Numeric: "400", Digits: 3, Name: "Jordanian Dinar"},
+	JPY: &Currency{Code: "JPY", Symbol: "¥", Numeric: "392", Digits: 0, Name: "Yen"},
+	KES: &Currency{Code: "KES", Symbol: "S", Numeric: "404", Digits: 2, Name: "Kenyan Shilling"},
+	KGS: &Currency{Code: "KGS", Symbol: "сом", Numeric: "417", Digits: 2, Name: "Som"},
+	KHR: &Currency{Code: "KHR", Symbol: "៛", Numeric: "116", Digits: 2, Name: "Riel"},
+	KMF: &Currency{Code: "KMF", Symbol: "KMF", Numeric: "174", Digits: 0, Name: "Comorian Franc"},
+	KPW: &Currency{Code: "KPW", Symbol: "₩", Numeric: "408", Digits: 2, Name: "North Korean Won"},
+	KRW: &Currency{Code: "KRW", Symbol: "₩", Numeric: "410", Digits: 0, Name: "Won"},
+	KWD: &Currency{Code: "KWD", Symbol: "د.ك.‏", Numeric: "414", Digits: 3, Name: "Kuwaiti Dinar"},
+	KYD: &Currency{Code: "KYD", Symbol: "$", Numeric: "136", Digits: 2, Name: "Cayman Islands Dollar"},
+	KZT: &Currency{Code: "KZT", Symbol: "Т", Numeric: "398", Digits: 2, Name: "Tenge"},
+	LAK: &Currency{Code: "LAK", Symbol: "₭", Numeric: "418", Digits: 2, Name: "Lao Kip"},
+	LBP: &Currency{Code: "LBP", Symbol: "ل.ل.‏", Numeric: "422", Digits: 2, Name: "Lebanese Pound"},
+	LKR: &Currency{Code: "LKR", Symbol: "රු.", Numeric: "144", Digits: 2, Name: "Sri Lanka Rupee"},
+	LRD: &Currency{Code: "LRD", Symbol: "$", Numeric: "430", Digits: 2, Name: "Liberian Dollar"},
+	LSL: &Currency{Code: "LSL", Symbol: "LSL", Numeric: "426", Digits: 2, Name: "Loti"},
+	LTL: &Currency{Code: "LTL", Symbol: "Lt", Numeric: "440", Digits: 2, Name: "Lithuanian Litas"},
+	LVL: &Currency{Code: "LVL", Symbol: "Ls", Numeric: "428", Digits: 2, Name: "Latvian Lats"},
+	LYD: &Currency{Code: "LYD", Symbol: "د.ل.‏", Numeric: "434", Digits: 3, Name: "Libyan Dinar"},
+	MAD: &Currency{Code: "MAD", Symbol: "د.م.‏", Numeric: "504", Digits: 2, Name: "Moroccan Dirham"},
+	MDL: &Currency{Code: "MDL", Symbol: "MDL", Numeric: "498", Digits: 2, Name: "Moldovan Leu"},
+	MGA: &Currency{Code: "MGA", Symbol: "MGA", Numeric: "969", Digits: 2, Name: "Malagasy Ariary"},
+	MKD: &Currency{Code: "MKD", Symbol: "ден.", Numeric: "807", Digits: 2, Name: "Denar"},
+	MMK: &Currency{Code: "MMK", Symbol: "MMK", Numeric: "104", Digits: 2, Name: "Kyat"},
+	MNT: &Currency{Code: "MNT", Symbol: "₮", Numeric: "496", Digits: 2, Name: "Tugrik"},
+	MOP: &Currency{Code: "MOP", Symbol: "MOP", Numeric: "446", Digits: 2, Name: "Pataca"},
+	MRU: &Currency{Code: "MRU", Symbol: "MRU", Numeric: "929", Digits: 2, Name: "Ouguiya"},
+	MUR: &Currency{Code: "MUR", Symbol: "₨", Numeric: "480", Digits: 2, Name: "Mauritius Rupee"},
+	MVR: &Currency{Code: "MVR", Symbol: "ރ.", Numeric: "462", Digits: 2, Name: "Rufiyaa"},
+	MWK: &Currency{Code: "MWK", Symbol: "MWK", Numeric: "454", Digits: 2, Name: "Malawi Kwacha"},
+	MXN: &Currency{Code: "MXN", Symbol: "$", Numeric: "484", Digits: 2, Name: "Mexican Peso"},
+	MXV: &Currency{Code: "MXV", Symbol: "MXV", Numeric: "979", Digits: 2, Name: "Mexican Unidad de Inversion (UDI)"},
+	MYR: &Currency{Code: "MYR", Symbol: "RM", Numeric: "458", Digits: 2, Name: "Malaysian Ringgit"},
+	MZN: &Currency{Code: "MZN", Symbol: "MZN", Numeric: "943", Digits: 2, Name: "Mozambique Metical"},
+	NAD: &Currency{Code: "NAD", Symbol: "$", Numeric: "516", Digits: 2, Name: "Namibia Dollar"},
+	NGN: &Currency{Code: "NGN", Symbol: "₦", Numeric: "566", Digits: 2, Name: "Naira"},
+	NIO: &Currency{Code: "NIO", Symbol: "N", Numeric: "558", Digits: 2, Name: "Cordoba Oro"},
+	NOK: &Currency{Code: "NOK", Symbol: "kr", Numeric: "578", Digits: 2, Name: "Norwegian Krone", CashIncrement: 100, CashDigits: 0},
+	NPR: &Currency{Code: "NPR", Symbol: "रु", Numeric: "524", Digits: 2, Name: "Nepalese Rupee"},
+	NZD: &Currency{Code: "NZD", Symbol: "$", Numeric: "554", Digits: 2, Name: "New Zealand Dollar", CashIncrement: 10, CashDigits: 2},
+	OMR: &Currency{Code: "OMR", Symbol: "ر.ع.‏", Numeric: "512", Digits: 3, Name: "Rial Omani"},
+	PAB: &Currency{Code: "PAB", Symbol: "B/.", Numeric: "590", Digits: 2, Name: "Balboa"},
+	PEN: &Currency{Code: "PEN", Symbol: "S/.", Numeric: "604", Digits: 2, Name: "Sol"},
+	PGK: &Currency{Code: "PGK", Symbol: "PGK", Numeric: "598", Digits: 2, Name: "Kina"},
+	PHP: &Currency{Code: "PHP", Symbol: "PhP", Numeric: "608", Digits: 2, Name: "Philippine Peso"},
+	PKR: &Currency{Code: "PKR", Symbol: "Rs", Numeric: "586", Digits: 2, Name: "Pakistan Rupee"},
+	PLN: &Currency{Code: "PLN", Symbol: "zł", Numeric: "985", Digits: 2, Name: "Zloty"},
+	PYG: &Currency{Code: "PYG", Symbol: "Gs", Numeric: "600", Digits: 0, Name: "Guarani"},
+	QAR: &Currency{Code: "QAR", Symbol: "ر.ق.‏", Numeric: "634", Digits: 2, Name: "Qatari Rial"},
+	RON: &Currency{Code: "RON", Symbol: "lei", Numeric: "946", Digits: 2, Name: "Romanian Leu"},
+	RSD: &Currency{Code: "RSD", Symbol: "Din.", Numeric: "941", Digits: 2, Name: "Serbian Dinar"},
+	RUB: &Currency{Code: "RUB", Symbol: "р.", Numeric: "643", Digits: 2, Name: "Russian Ruble"},
+	RWF: &Currency{Code: "RWF", Symbol: "RWF", Numeric: "646", Digits: 0, Name: "Rwanda Franc"},
+	SAR: &Currency{Code: "SAR", Symbol: "ر.س.‏", Numeric: "682", Digits: 2, Name: "Saudi Riyal"},
+	SBD: &Currency{Code: "SBD", Symbol: "$", Numeric: "090", Digits: 2, Name: "Solomon Islands Dollar"},
+	SCR: &Currency{Code: "SCR", Symbol: "₨", Numeric: "690", Digits: 2, Name: "Seychelles Rupee"},
+	SDG: &Currency{Code: "SDG", Symbol: "SDG", Numeric: "938", Digits: 2, Name: "Sudanese Pound"},
+	SEK: &Currency{Code: "SEK", Symbol: "kr", Numeric: "752", Digits: 2, Name: "Swedish Krona", CashIncrement: 100, CashDigits: 0},
+	SGD: &Currency{Code: "SGD", Symbol: "$", Numeric: "702", Digits: 2, Name: "Singapore Dollar"},
+	SHP: &Currency{Code: "SHP", Symbol: "£", Numeric: "654", Digits: 2, Name: "Saint Helena Pound"},
+	SLE: &Currency{Code: "SLE", Symbol: "SLE", Numeric: "925", Digits: 2, Name: "Leone"},
+	SLL: &Currency{Code: "SLL", Symbol: "SLL", Numeric: "694", Digits: 2, Name: "Leone (1964-2023)"},
+	SOS: &Currency{Code: "SOS", Symbol: "SOS", Numeric: "706", Digits: 2, Name: "Somali Shilling"},
+	SRD: &Currency{Code: "SRD", Symbol: "$", Numeric: "968", Digits: 2, Name: "Surinam Dollar"},
+	SSP: &Currency{Code: "SSP", Symbol: "SSP", Numeric: "728", Digits: 2, Name: "South Sudanese Pound"},
+	STN: &Currency{Code: "STN", Symbol: "STN", Numeric: "930", Digits: 2, Name: "Dobra"},
+	SVC: &Currency{Code: "SVC", Symbol: "SVC", Numeric: "222", Digits: 2, Name: "El Salvador Colon"},
+	SYP: &Currency{Code: "SYP", Symbol: "ل.س.‏", Numeric: "760", Digits: 2, Name: "Syrian Pound"},
+	SZL: &Currency{Code: "SZL", Symbol: "SZL", Numeric: "748", Digits: 2, Name: "Lilangeni"},
+	THB: &Currency{Code: "THB", Symbol: "฿", Numeric: "764", Digits: 2, Name: "Baht"},
+	TJS: &Currency{Code: "TJS", Symbol: "т.р.", Numeric: "972", Digits: 2, Name: "Somoni"},
+	TMT: &Currency{Code: "TMT", Symbol: "m.", Numeric: "934", Digits: 2, Name: "Turkmenistan New Manat"},
+	TND: &Currency{Code: "TND", Symbol: "د.ت.‏", Numeric: "788", Digits: 3, Name: "Tunisian Dinar"},
+	TOP: &Currency{Code: "TOP", Symbol: "TOP", Numeric: "776", Digits: 2, Name: "Pa'anga"},
+	TRY: &Currency{Code: "TRY", Symbol: "TL", Numeric: "949", Digits: 2, Name: "Turkish Lira"},
+	TTD: &Currency{Code: "TTD", Symbol: "TT$", Numeric: "780", Digits: 2, Name: "Trinidad and Tobago Dollar"},
+	TWD: &Currency{Code: "TWD", Symbol: "NT$", Numeric: "901", Digits: 2, Name: "New Taiwan Dollar", CashIncrement: 100, CashDigits: 0},
+	TZS: &Currency{Code: "TZS", Symbol: "TZS", Numeric: "834", Digits: 2, Name: "Tanzanian Shilling"},
+	UAH: &Currency{Code: "UAH", Symbol: "₴", Numeric: "980", Digits: 2, Name: "Hryvnia"},
+	UGX: &Currency{Code: "UGX", Symbol: "UGX", Numeric: "800", Digits: 0, Name: "Uganda Shilling"},
+	USD: &Currency{Code: "USD", Symbol: "$", Numeric: "840", Digits: 2, Name: "US Dollar"},
+	USN: &Currency{Code: "USN", Symbol: "USN", Numeric: "997", Digits: 2, Name: "US Dollar (Next day)"},
+	UYI: &Currency{Code: "UYI", Symbol: "UYI", Numeric: "940", Digits: 0, Name: "Uruguay Peso en Unidades Indexadas (UI)"},
+	UYU: &Currency{Code: "UYU", Symbol: "$U", Numeric: "858", Digits: 2, Name: "Peso Uruguayo"},
+	UYW: &Currency{Code: "UYW", Symbol: "UYW", Numeric: "927", Digits: 4, Name: "Unidad Previsional"},
+	UZS: &Currency{Code: "UZS", Symbol: "so'm", Numeric: "860", Digits: 2, Name: "Uzbekistan Sum"},
+	VED: &Currency{Code: "VED", Symbol: "VED", Numeric: "926", Digits: 2, Name: "Bolivar Soberano"},
+	VEF: &Currency{Code: "VEF", Symbol: "Bs. F.", Numeric: "937", Digits: 2, Name: "Bolivar Fuerte"},
+	VES: &Currency{Code: "VES", Symbol: "VES", Numeric: "928", Digits: 2, Name: "Bolivar Soberano"},
+	VND: &Currency{Code: "VND", Symbol: "₫", Numeric: "704", Digits: 0, Name: "Dong"},
+	VUV: &Currency{Code: "VUV", Symbol: "VUV", Numeric: "548", Digits: 0, Name: "Vatu"},
+	WST: &Currency{Code: "WST", Symbol: "WST", Numeric: "882", Digits: 2, Name: "Tala"},
+	XAF: &Currency{Code: "XAF", Symbol: "FCFA", Numeric: "950", Digits: 0, Name: "CFA Franc BEAC"},
+	XAG: &Currency{Code: "XAG", Symbol: "XAG", Numeric: "961", Digits: 2, Name: "Silver"},
+	XAU: &Currency{Code: "XAU", Symbol: "XAU", Numeric: "959", Digits: 2, Name: "Gold"},
+	XBA: &Currency{Code: "XBA", Symbol: "XBA", Numeric: "955", Digits: 2, Name: "Bond Markets Unit European Composite Unit (EURCO)"},
+	XBB: &Currency{Code: "XBB", Symbol: "XBB", Numeric: "956", Digits: 2, Name: "Bond Markets Unit European Monetary Unit (E.M.U.-6)"},
+	XBC: &Currency{Code: "XBC", Symbol: "XBC", Numeric: "957", Digits: 2, Name: "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)"},
+	XBD: &Currency{Code: "XBD", Symbol: "XBD", Numeric: "958", Digits: 2, Name: "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)"},
+	XCD: &Currency{Code: "XCD", Symbol: "$", Numeric: "951", Digits: 2, Name: "East Caribbean Dollar"},
+	XDR: &Currency{Code: "XDR", Symbol: "XDR", Numeric: "960", Digits: 2, Name: "SDR (Special Drawing Right)"},
+	XOF: &Currency{Code: "XOF", Symbol: "XOF", Numeric: "952", Digits: 0, Name: "CFA Franc BCEAO"},
+	XPD: &Currency{Code: "XPD", Symbol: "XPD", Numeric: "964", Digits: 2, Name: "Palladium"},
+	XPF: &Currency{Code: "XPF", Symbol: "XPF", Numeric: "953", Digits: 0, Name: "CFP Franc"},
+	XPT: &Currency{Code: "XPT", Symbol: "XPT", Numeric: "962", Digits: 2, Name: "Platinum"},
+	XSU: &Currency{Code: "XSU", Symbol: "XSU", Numeric: "994", Digits: 2, Name: "Sucre"},
+	XTS: &Currency{Code: "XTS", Symbol: "XTS", Numeric: "963", Digits: 2, Name: "Codes specifically reserved for testing purposes"},
+	XUA: &Currency{Code: "XUA", Symbol: "XUA", Numeric: "965", Digits: 2, Name: "ADB Unit of Account"},
+	XXX: &Currency{Code: "XXX", Symbol: "XXX", Numeric: "999", Digits: 2, Name: "The codes assigned for transactions where no currency is involved"},
+	YER: &Currency{Code: "YER", Symbol: "ر.ي.‏", Numeric: "886", Digits: 2, Name: "Yemeni Rial"},
+	ZAR: &Currency{Code: "ZAR", Symbol: "R", Numeric: "710", Digits: 2, Name: "Rand"},
+	ZMW: &Currency{Code: "ZMW", Symbol: "ZMW", Numeric: "967", Digits: 2, Name: "Zambian Kwacha"},
+	ZWG: &Currency{Code: "ZWG", Symbol: "ZWG", Numeric: "924", Digits: 2, Name: "Zimbabwe Gold"},
+	ZWL: &Currency{Code: "ZWL", Symbol: "Z$", Numeric: "932", Digits: 2, Name: "Zimbabwe Dollar"},
 }
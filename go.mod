@@ -1,3 +1,3 @@
 module github.com/reillywatson/i18n
 
-go 1.12
+go 1.13
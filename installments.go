@@ -0,0 +1,178 @@
+package i18n
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrMoneyInvalidInterestRate is returned for interest rates or period
+// lengths that are negative.
+var ErrMoneyInvalidInterestRate = errors.New("i18n: invalid interest rate")
+
+// ErrMoneyTooManyInstallments is returned for more than MaxInstallments
+// periods.
+var ErrMoneyTooManyInstallments = errors.New("i18n: too many installments")
+
+// MaxInstallments is the largest number of periods of
+// Money.PlanInstallments, i.e. 100 years of monthly installments. The
+// exact balance grows with each period, so the number of periods is
+// limited to bound the time and memory of a plan.
+const MaxInstallments = 1200
+
+// InstallmentOptions are the options of Money.PlanInstallments.
+type InstallmentOptions struct {
+	// Rate is the interest rate per period, e.g. 6/1200 for 6% per year
+	// paid monthly. Nil or zero means no interest, which splits the
+	// principal like Money.Split.
+	Rate *big.Rat
+	// FirstPeriod is the length of the first period relative to the others,
+	// e.g. 45/30 if the first installment is due after 45 days rather than
+	// 30. The interest of the longer or shorter period is added to or taken
+	// off the first installment. Nil means a regular first period.
+	FirstPeriod *big.Rat
+	// RemainderFirst pushes the rounding remainder to the first installment
+	// rather than the last one.
+	RemainderFirst bool
+	// Mode is the rounding mode of the installments and the interest.
+	Mode RoundingMode
+}
+
+// Installment is an installment of an InstallmentPlan.
+type Installment struct {
+	// Payment is the amount due, i.e. Principal plus Interest.
+	Payment Money
+	// Principal is the part of the payment that repays the principal.
+	Principal Money
+	// Interest is the part of the payment that pays the interest.
+	Interest Money
+	// Balance is the principal that is outstanding after the payment.
+	Balance Money
+}
+
+// InstallmentPlan is the result of Money.PlanInstallments.
+type InstallmentPlan struct {
+	Installments []Installment
+	// Interest is the total interest of all installments.
+	Interest Money
+	// Total is the sum of all payments, i.e. the principal plus Interest.
+	Total Money
+}
+
+// Payments returns the payments of the installments of p.
+func (p InstallmentPlan) Payments() []Money {
+	payments := make([]Money, len(p.Installments))
+	for i, installment := range p.Installments {
+		payments[i] = installment.Payment
+	}
+	return payments
+}
+
+// PlanInstallments returns an amortization schedule that repays the
+// principal m in the given number of installments of equal payments (an
+// annuity), with interest on the outstanding balance.
+//
+// The exact payments and interest are rounded to the minor unit of the
+// currency, and the remainder of the rounding goes to the last (or first)
+// installment. The payments always add up to m plus the total interest,
+// and the principal parts to m, in minor units. Without interest,
+// Money{C: "CAD", M: 10}.PlanInstallments(3, InstallmentOptions{RemainderFirst: true})
+// pays {4, 3, 3} like Split.
+//
+// It returns ErrMoneyZeroOrLessChunks if periods is zero or less,
+// ErrMoneyTooManyInstallments if it is more than MaxInstallments,
+// ErrMoneyInvalidInterestRate if the rate or the first period is negative,
+// and ErrMoneyOverflow if an amount doesn't fit into a Money.
+func (m Money) PlanInstallments(periods int64, opts InstallmentOptions) (InstallmentPlan, error) {
+	if periods <= 0 {
+		return InstallmentPlan{}, ErrMoneyZeroOrLessChunks
+	}
+	if periods > MaxInstallments {
+		return InstallmentPlan{}, ErrMoneyTooManyInstallments
+	}
+	rate := new(big.Rat)
+	if opts.Rate != nil {
+		rate.Set(opts.Rate)
+	}
+	first := big.NewRat(1, 1)
+	if opts.FirstPeriod != nil {
+		first.Set(opts.FirstPeriod)
+	}
+	if rate.Sign() < 0 || first.Sign() < 0 {
+		return InstallmentPlan{}, ErrMoneyInvalidInterestRate
+	}
+
+	// The exact payment of an annuity is P*r / (1 - (1+r)^-n), or P/n
+	// without interest. The interest of the first period is P*r*f, and its
+	// payment changes by the interest of the difference to a regular period,
+	// so that the principal parts are those of a regular annuity.
+	principal := new(big.Rat).SetInt64(m.M)
+	payment := new(big.Rat).Quo(principal, new(big.Rat).SetInt64(periods))
+	if rate.Sign() > 0 {
+		growth := ratPow(new(big.Rat).Add(rate, big.NewRat(1, 1)), periods)
+		payment.Mul(principal, rate)
+		payment.Mul(payment, growth)
+		payment.Quo(payment, growth.Sub(growth, big.NewRat(1, 1)))
+	}
+	firstInterest := new(big.Rat).Mul(principal, rate)
+	firstInterest.Mul(firstInterest, first)
+	firstPayment := new(big.Rat).Sub(firstInterest, new(big.Rat).Mul(principal, rate))
+	firstPayment.Add(firstPayment, payment)
+
+	// The interest of each period is rounded as the difference of the
+	// rounded running totals, so that the rounded interest adds up to the
+	// rounded total interest.
+	exactInterest := new(big.Rat)
+	interest := make([]*big.Int, periods)
+	payments := make([]*big.Int, periods)
+	balance := new(big.Rat).Set(principal)
+	rounded := new(big.Int)
+	paid := new(big.Int)
+	for k := range payments {
+		i, p := new(big.Rat).Mul(balance, rate), payment
+		if k == 0 {
+			i, p = firstInterest, firstPayment
+		}
+		balance.Sub(balance, new(big.Rat).Sub(p, i))
+		exactInterest.Add(exactInterest, i)
+		total := opts.Mode.round(exactInterest.Num(), exactInterest.Denom())
+		interest[k] = new(big.Int).Sub(total, rounded)
+		rounded = total
+		payments[k] = opts.Mode.round(p.Num(), p.Denom())
+		paid.Add(paid, payments[k])
+	}
+	total := new(big.Int).Add(big.NewInt(m.M), rounded)
+	remainder := payments[periods-1]
+	if opts.RemainderFirst {
+		remainder = payments[0]
+	}
+	remainder.Add(remainder, paid.Sub(total, paid))
+
+	plan := InstallmentPlan{Installments: make([]Installment, periods)}
+	outstanding := big.NewInt(m.M)
+	for k := range payments {
+		principal := new(big.Int).Sub(payments[k], interest[k])
+		outstanding.Sub(outstanding, principal)
+		if !payments[k].IsInt64() || !principal.IsInt64() || !interest[k].IsInt64() || !outstanding.IsInt64() {
+			return InstallmentPlan{}, ErrMoneyOverflow
+		}
+		plan.Installments[k] = Installment{
+			Payment:   Money{M: payments[k].Int64(), C: m.C},
+			Principal: Money{M: principal.Int64(), C: m.C},
+			Interest:  Money{M: interest[k].Int64(), C: m.C},
+			Balance:   Money{M: outstanding.Int64(), C: m.C},
+		}
+	}
+	if !rounded.IsInt64() || !total.IsInt64() {
+		return InstallmentPlan{}, ErrMoneyOverflow
+	}
+	plan.Interest = Money{M: rounded.Int64(), C: m.C}
+	plan.Total = Money{M: total.Int64(), C: m.C}
+	return plan, nil
+}
+
+// ratPow returns r to the power of n, for n >= 0.
+func ratPow(r *big.Rat, n int64) *big.Rat {
+	num := new(big.Int).Exp(r.Num(), big.NewInt(n), nil)
+	den := new(big.Int).Exp(r.Denom(), big.NewInt(n), nil)
+	return new(big.Rat).SetFrac(num, den)
+}
@@ -0,0 +1,116 @@
+package i18n
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestPlanInstallments(t *testing.T) {
+	plan, err := Money{M: 1000000, C: EUR}.PlanInstallments(12, InstallmentOptions{Rate: big.NewRat(6, 1200)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.Interest != (Money{M: 32797, C: EUR}) || plan.Total != (Money{M: 1032797, C: EUR}) {
+		t.Errorf("expected 327.97 EUR interest and 10327.97 EUR in total, got %v and %v", plan.Interest, plan.Total)
+	}
+	expected := []Installment{
+		{Payment: Money{M: 86066, C: EUR}, Principal: Money{M: 81066, C: EUR}, Interest: Money{M: 5000, C: EUR}, Balance: Money{M: 918934, C: EUR}},
+		{Payment: Money{M: 86066, C: EUR}, Principal: Money{M: 81471, C: EUR}, Interest: Money{M: 4595, C: EUR}, Balance: Money{M: 837463, C: EUR}},
+	}
+	if !reflect.DeepEqual(plan.Installments[:2], expected) {
+		t.Errorf("expected %+v, got %+v", expected, plan.Installments[:2])
+	}
+	last := Installment{Payment: Money{M: 86071, C: EUR}, Principal: Money{M: 85643, C: EUR}, Interest: Money{M: 428, C: EUR}, Balance: Money{C: EUR}}
+	if plan.Installments[11] != last {
+		t.Errorf("expected the remainder in the last installment %+v, got %+v", last, plan.Installments[11])
+	}
+	checkInstallmentPlan(t, Money{M: 1000000, C: EUR}, plan)
+}
+
+func TestPlanInstallmentsOptions(t *testing.T) {
+	tests := []struct {
+		m        Money
+		periods  int64
+		opts     InstallmentOptions
+		payments []int64
+		interest []int64
+	}{
+		// Without interest, like Split.
+		{Money{M: 10, C: CAD}, 3, InstallmentOptions{RemainderFirst: true}, []int64{4, 3, 3}, []int64{0, 0, 0}},
+		{Money{M: 11, C: CAD}, 3, InstallmentOptions{}, []int64{4, 4, 3}, []int64{0, 0, 0}},
+		{Money{M: 11, C: CAD}, 3, InstallmentOptions{Mode: RoundTruncate}, []int64{3, 3, 5}, []int64{0, 0, 0}},
+		{Money{M: -10, C: CAD}, 4, InstallmentOptions{}, []int64{-2, -2, -2, -4}, []int64{0, 0, 0, 0}},
+		{Money{M: 7, C: CAD}, 1, InstallmentOptions{Rate: big.NewRat(1, 10)}, []int64{8}, []int64{1}},
+		// Longer and shorter first periods, e.g. 45 rather than 30 days.
+		{Money{M: 100000, C: EUR}, 3, InstallmentOptions{Rate: big.NewRat(1, 100), FirstPeriod: big.NewRat(3, 2)}, []int64{34502, 34002, 34003}, []int64{1500, 670, 337}},
+		{Money{M: 100000, C: EUR}, 3, InstallmentOptions{Rate: big.NewRat(1, 100), FirstPeriod: big.NewRat(3, 2), RemainderFirst: true}, []int64{34503, 34002, 34002}, []int64{1500, 670, 337}},
+		{Money{M: 100000, C: EUR}, 3, InstallmentOptions{Rate: big.NewRat(1, 100), FirstPeriod: big.NewRat(1, 2)}, []int64{33502, 34002, 34003}, []int64{500, 670, 337}},
+		{Money{M: 100000, C: JPY}, 2, InstallmentOptions{Rate: big.NewRat(1, 100)}, []int64{50751, 50751}, []int64{1000, 502}},
+	}
+	for _, test := range tests {
+		plan, err := test.m.PlanInstallments(test.periods, test.opts)
+		if err != nil {
+			t.Errorf("%v %d %+v: unexpected error: %v", test.m, test.periods, test.opts, err)
+			continue
+		}
+		var payments, interest []int64
+		for _, installment := range plan.Installments {
+			payments = append(payments, installment.Payment.M)
+			interest = append(interest, installment.Interest.M)
+		}
+		if !reflect.DeepEqual(payments, test.payments) || !reflect.DeepEqual(interest, test.interest) {
+			t.Errorf("%v %d %+v: expected payments %v with interest %v, got %v with %v", test.m, test.periods, test.opts, test.payments, test.interest, payments, interest)
+		}
+		checkInstallmentPlan(t, test.m, plan)
+	}
+}
+
+// checkInstallmentPlan checks that the payments of plan add up exactly.
+func checkInstallmentPlan(t *testing.T, m Money, plan InstallmentPlan) {
+	t.Helper()
+	principal, interest := Money{C: m.C}, Money{C: m.C}
+	for i, installment := range plan.Installments {
+		if installment.Principal.Add(installment.Interest) != installment.Payment {
+			t.Errorf("%v: installment %d doesn't add up: %+v", m, i, installment)
+		}
+		principal = principal.Add(installment.Principal)
+		interest = interest.Add(installment.Interest)
+		if installment.Balance != m.Sub(principal) {
+			t.Errorf("%v: installment %d: expected balance %v, got %v", m, i, m.Sub(principal), installment.Balance)
+		}
+	}
+	sum, err := Sum(plan.Payments()...)
+	if err != nil || sum != plan.Total {
+		t.Errorf("%v: expected payments to add up to %v, got %v, %v", m, plan.Total, sum, err)
+	}
+	if principal != m || interest != plan.Interest || m.Add(interest) != plan.Total {
+		t.Errorf("%v: expected principal %v and interest %v, got %v and %v", m, m, plan.Interest, principal, interest)
+	}
+}
+
+func TestPlanInstallmentsErrors(t *testing.T) {
+	m := Money{M: 1000, C: EUR}
+	if _, err := m.PlanInstallments(0, InstallmentOptions{}); !errors.Is(err, ErrMoneyZeroOrLessChunks) {
+		t.Errorf("expected ErrMoneyZeroOrLessChunks, got %v", err)
+	}
+	if _, err := m.PlanInstallments(MaxInstallments, InstallmentOptions{}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	for _, periods := range []int64{MaxInstallments + 1, math.MaxInt64} {
+		if _, err := m.PlanInstallments(periods, InstallmentOptions{}); !errors.Is(err, ErrMoneyTooManyInstallments) {
+			t.Errorf("%d: expected ErrMoneyTooManyInstallments, got %v", periods, err)
+		}
+	}
+	if _, err := m.PlanInstallments(3, InstallmentOptions{Rate: big.NewRat(-1, 100)}); !errors.Is(err, ErrMoneyInvalidInterestRate) {
+		t.Errorf("expected ErrMoneyInvalidInterestRate, got %v", err)
+	}
+	if _, err := m.PlanInstallments(3, InstallmentOptions{FirstPeriod: big.NewRat(-1, 2)}); !errors.Is(err, ErrMoneyInvalidInterestRate) {
+		t.Errorf("expected ErrMoneyInvalidInterestRate, got %v", err)
+	}
+	if _, err := (Money{M: math.MaxInt64, C: EUR}).PlanInstallments(3, InstallmentOptions{Rate: big.NewRat(1, 100)}); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("expected ErrMoneyOverflow, got %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrMoneyInvalidJSON is returned when unmarshalling JSON that doesn't
+// describe a single, consistent Money.
+var ErrMoneyInvalidJSON = errors.New("i18n: invalid money JSON")
+
+// JSONMode specifies the shape of the JSON that MoneyJSON and BigMoneyJSON
+// produce. Money.MarshalJSON always uses JSONLegacy. Money.UnmarshalJSON
+// accepts all shapes, regardless of the mode.
+type JSONMode int
+
+const (
+	// JSONLegacy encodes the minor units, the currency and the amount as a
+	// float64, e.g. {"M":123456,"C":"USD","F":1234.56}. F is informational
+	// only, as it cannot represent all amounts exactly.
+	JSONLegacy JSONMode = iota
+	// JSONDecimal encodes the amount as a decimal string, e.g.
+	// {"amount":"1234.56","currency":"USD"}.
+	JSONDecimal
+	// JSONMinorUnits encodes the minor units and the currency only, e.g.
+	// {"M":123456,"C":"USD"}.
+	JSONMinorUnits
+)
+
+type moneyMarshalContainer struct {
+	M        *big.Int      `json:"M,omitempty"`
+	C        CurrencyCode  `json:"C,omitempty"`
+	F        *float64      `json:"F,omitempty"`
+	Amount   *string       `json:"amount,omitempty"`
+	Currency *CurrencyCode `json:"currency,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, using JSONLegacy. Use MoneyJSON
+// for the other modes.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return marshalMoneyJSON(big.NewInt(m.M), m.C, m.Get(), JSONLegacy)
+}
+
+// MoneyJSON is a Money that is encoded in the given mode, e.g.
+//
+//	json.Marshal(MoneyJSON{Money: m, Mode: JSONDecimal})
+//
+// It is decoded like Money, and Mode is left unchanged.
+type MoneyJSON struct {
+	Money
+	Mode JSONMode
+}
+
+// MarshalJSON implements json.Marshaler, using m.Mode.
+func (m MoneyJSON) MarshalJSON() ([]byte, error) {
+	return marshalMoneyJSON(big.NewInt(m.M), m.C, m.Get(), m.Mode)
+}
+
+// marshalMoneyJSON encodes v minor units of currency c in the given mode.
+// f is the amount as a float64, for JSONLegacy.
+func marshalMoneyJSON(v *big.Int, c CurrencyCode, f float64, mode JSONMode) ([]byte, error) {
+	switch mode {
+	case JSONDecimal:
+		amount := decimalString(v, Money{C: c}.digits())
+		return json.Marshal(moneyMarshalContainer{Amount: &amount, Currency: &c})
+	case JSONMinorUnits:
+		return json.Marshal(struct {
+			M *big.Int     `json:"M"`
+			C CurrencyCode `json:"C"`
+		}{v, c})
+	}
+	return json.Marshal(struct {
+		M *big.Int     `json:"M"`
+		C CurrencyCode `json:"C"`
+		F float64      `json:"F"`
+	}{v, c, f})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the shapes of all
+// JSON modes. The minor units M take precedence over the float F; if both
+// are present, they must describe the same amount. Unknown currencies
+// return ErrUnknownCurrency, other problems ErrMoneyInvalidJSON. Use
+// BigMoney for amounts that don't fit into a Money. Like the types of
+// encoding/json, it leaves m unchanged for null.
+func (m *Money) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	v, err := unmarshalMoneyJSON(b)
+	if err != nil {
+		return err
+	}
+	n, err := v.Money()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMoneyInvalidJSON, err)
+	}
+	*m = n
+	return nil
+}
+
+// unmarshalMoneyJSON decodes any of the shapes of the JSON modes.
+func unmarshalMoneyJSON(b []byte) (BigMoney, error) {
+	var container moneyMarshalContainer
+	err := json.Unmarshal(b, &container)
+	if err != nil {
+		return BigMoney{}, err
+	}
+
+	c := container.C
+	if container.Currency != nil {
+		if c != "" && c != *container.Currency {
+			return BigMoney{}, fmt.Errorf("%w: currencies %q and %q disagree", ErrMoneyInvalidJSON, c, *container.Currency)
+		}
+		c = *container.Currency
+	}
+	if _, found := Currencies[c]; !found && c != "" {
+		return BigMoney{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, string(c))
+	}
+
+	v := BigMoney{C: c}
+	switch {
+	case container.Amount != nil:
+		v.M, err = parseBigDecimal(*container.Amount, c, *container.Amount)
+		if err != nil {
+			return BigMoney{}, fmt.Errorf("%w: amount: %v", ErrMoneyInvalidJSON, err)
+		}
+		if container.M != nil && container.M.Cmp(v.M) != 0 {
+			return BigMoney{}, fmt.Errorf("%w: M %v and amount %q disagree", ErrMoneyInvalidJSON, container.M, *container.Amount)
+		}
+	case container.M != nil:
+		v.M = container.M
+	case container.F != nil:
+		v.M, err = floatMinorUnits(c, *container.F)
+		if err != nil {
+			return BigMoney{}, fmt.Errorf("%w: F: %v", ErrMoneyInvalidJSON, err)
+		}
+	default:
+		return BigMoney{}, fmt.Errorf("%w: missing amount", ErrMoneyInvalidJSON)
+	}
+	if container.F != nil && container.M != nil && !v.matchesFloat(*container.F) {
+		return BigMoney{}, fmt.Errorf("%w: M %v and F %v disagree", ErrMoneyInvalidJSON, container.M, *container.F)
+	}
+	return v, nil
+}
+
+// isJSONNull reports whether b is the JSON literal null.
+func isJSONNull(b []byte) bool {
+	return string(bytes.TrimSpace(b)) == "null"
+}
+
+// floatMinorUnits returns f in minor units of currency c, taking f as a
+// decimal with 15 significant digits like MakeMoney.
+func floatMinorUnits(c CurrencyCode, f float64) (*big.Int, error) {
+	r, err := floatRat(f)
+	if err != nil {
+		return nil, err
+	}
+	r.Mul(r, new(big.Rat).SetInt64(Money{C: c}.dp()))
+	return RoundHalfCeiling.round(r.Num(), r.Denom()), nil
+}
+
+// matchesFloat reports whether f describes the amount of m, either as
+// written by JSONLegacy or rounded to the minor units of the currency.
+func (m BigMoney) matchesFloat(f float64) bool {
+	if v, err := m.Money(); err == nil && v.Get() == f {
+		return true
+	}
+	if exact, _ := m.rat().Float64(); exact == f {
+		return true
+	}
+	v, err := floatMinorUnits(m.C, f)
+	return err == nil && v.Cmp(m.int()) == 0
+}
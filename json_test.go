@@ -0,0 +1,135 @@
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMoneyMarshalJSON(t *testing.T) {
+	tests := []struct {
+		mode     JSONMode
+		m        Money
+		expected string
+	}{
+		{JSONLegacy, Money{M: 123456, C: USD}, `{"M":123456,"C":"USD","F":1234.56}`},
+		{JSONLegacy, Money{}, `{"M":0,"C":"","F":0}`},
+		{JSONDecimal, Money{M: 123456, C: USD}, `{"amount":"1234.56","currency":"USD"}`},
+		{JSONDecimal, Money{M: -5, C: EUR}, `{"amount":"-0.05","currency":"EUR"}`},
+		{JSONDecimal, Money{M: 1234, C: KWD}, `{"amount":"1.234","currency":"KWD"}`},
+		{JSONDecimal, Money{M: 1234, C: JPY}, `{"amount":"1234","currency":"JPY"}`},
+		{JSONMinorUnits, Money{M: 123456, C: USD}, `{"M":123456,"C":"USD"}`},
+	}
+	for _, test := range tests {
+		b, err := json.Marshal(MoneyJSON{Money: test.m, Mode: test.mode})
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", test.m, err)
+		}
+		if string(b) != test.expected {
+			t.Errorf("%v: expected %s, got %s", test.m, test.expected, b)
+		}
+		if test.mode == JSONLegacy {
+			if b, _ := json.Marshal(test.m); string(b) != test.expected {
+				t.Errorf("%v: expected %s for Money, got %s", test.m, test.expected, b)
+			}
+		}
+	}
+
+	v := struct {
+		P MoneyJSON
+	}{MoneyJSON{Mode: JSONDecimal}}
+	if err := json.Unmarshal([]byte(`{"P":{"M":5,"C":"EUR"}}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.P.Money != (Money{M: 5, C: EUR}) || v.P.Mode != JSONDecimal {
+		t.Errorf("expected 0.05 EUR in JSONDecimal, got %+v", v.P)
+	}
+}
+
+func TestMoneyJSONRoundTrip(t *testing.T) {
+	amounts := []Money{
+		{M: 0, C: USD},
+		{M: 123456, C: USD},
+		{M: 9007199254740993, C: USD},
+		{M: math.MaxInt64, C: EUR},
+		{M: math.MinInt64, C: JPY},
+		{M: -1, C: KWD},
+	}
+	for _, mode := range []JSONMode{JSONLegacy, JSONDecimal, JSONMinorUnits} {
+		for _, m := range amounts {
+			b, err := json.Marshal(MoneyJSON{Money: m, Mode: mode})
+			if err != nil {
+				t.Errorf("mode %d, %v: unexpected error: %v", mode, m, err)
+				continue
+			}
+			var got Money
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Errorf("mode %d, %s: unexpected error: %v", mode, b, err)
+			}
+			if got != m {
+				t.Errorf("mode %d: expected %#v, got %#v from %s", mode, m, got, b)
+			}
+		}
+	}
+}
+
+func TestMoneyUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		JSON     string
+		expected Money
+		err      error
+	}{
+		{`{"amount":"1234.56","currency":"USD"}`, Money{M: 123456, C: USD}, nil},
+		{`{"amount":"1234.5","currency":"USD"}`, Money{M: 123450, C: USD}, nil},
+		{`{"amount":"1234.56","currency":"USD","M":123456}`, Money{M: 123456, C: USD}, nil},
+		{`{"amount":"1234.56","C":"USD"}`, Money{M: 123456, C: USD}, nil},
+		{`{"M":123456,"C":"USD","F":1234.56}`, Money{M: 123456, C: USD}, nil},
+		{`{"M":123456,"C":"USD","F":1234.555}`, Money{M: 123456, C: USD}, nil},
+		{`{"F":1234.56,"C":"USD"}`, Money{M: 123456, C: USD}, nil},
+		{`{"M":123456,"C":"USD","F":1234.57}`, Money{}, ErrMoneyInvalidJSON},
+		{`{"amount":"1234.56","currency":"USD","M":1}`, Money{}, ErrMoneyInvalidJSON},
+		{`{"amount":"1234.567","currency":"USD"}`, Money{}, ErrMoneyInvalidJSON},
+		{`{"amount":"1,234.56","currency":"USD"}`, Money{}, ErrMoneyInvalidJSON},
+		{`{"amount":"1234.56","currency":"USD","C":"EUR"}`, Money{}, ErrMoneyInvalidJSON},
+		{`{"C":"USD"}`, Money{}, ErrMoneyInvalidJSON},
+		{`{"F":1e300,"C":"USD"}`, Money{}, ErrMoneyInvalidJSON},
+		{`{"M":1,"C":"XXY"}`, Money{}, ErrUnknownCurrency},
+		{`{"amount":"1","currency":"usd"}`, Money{}, ErrUnknownCurrency},
+		{`null`, Money{}, nil},
+	}
+	for _, test := range tests {
+		var m Money
+		err := json.Unmarshal([]byte(test.JSON), &m)
+		if test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf("%s: expected %v, got %v", test.JSON, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.JSON, err)
+		}
+		if m != test.expected {
+			t.Errorf("%s: expected %#v, got %#v", test.JSON, test.expected, m)
+		}
+	}
+}
+
+func TestMoneyUnmarshalJSONNull(t *testing.T) {
+	v := struct {
+		P Money
+		B BigMoney
+		S ScaledMoney
+	}{P: Money{M: 1, C: USD}}
+	if err := json.Unmarshal([]byte(`{"P":null,"B":null,"S":null}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.P != (Money{M: 1, C: USD}) || v.B.M != nil || v.S.M != nil {
+		t.Errorf("expected null to leave the fields unchanged, got %+v", v)
+	}
+	var p struct{ P *Money }
+	if err := json.Unmarshal([]byte(`{"P":null}`), &p); err != nil || p.P != nil {
+		t.Errorf("expected a nil pointer, got %v, %v", p.P, err)
+	}
+}
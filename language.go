@@ -8,4 +8,10 @@ type Language struct {
 	NativeName string
 	// EnglishName is the name of the language in English.
 	EnglishName string
+	// CompactSuffixes are the suffixes used for compact numbers in the
+	// language, for thousands, millions, billions and trillions, e.g.
+	// "K", "M", "B" and "T" in English. An empty suffix means that the
+	// magnitude isn't abbreviated. If the language has no compact
+	// suffixes, "K", "M", "G" and "T" are used.
+	CompactSuffixes []string
 }
@@ -36,6 +36,10 @@ type Locale struct {
 	NegativeSign string
 	// PositiveSign is the symbol to be used for positive numeric values.
 	PositiveSign string
+	// PercentPattern is the pattern used for percentages, where n is the
+	// number and % the percent (or per mille) symbol, e.g. "n %" for de_DE
+	// and "%n" for tr_TR. Empty means "n%".
+	PercentPattern string
 	// PercentSymbol is the symbol to be used for percentages.
 	PercentSymbol string
 	// PerMilleSymbol is the symbol to be used for per-mille values.
@@ -1,6 +1,7 @@
 package i18n
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -52,3 +53,31 @@ func TestLocales(t *testing.T) {
 		}
 	}
 }
+
+func TestLocalePercentPattern(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{"en_US", "n%"},
+		{"de_DE", "n %"},
+		{"de_AT", "n %"},
+		{"de_CH", "n%"},
+		{"fr_FR", "n %"},
+		{"fr_CH", "n%"},
+		{"es_ES", "n %"},
+		{"ja_JP", "n%"},
+		{"tr_TR", "%n"},
+		{"eu_ES", "% n"},
+	}
+	for _, test := range tests {
+		if got := Locales[test.code].PercentPattern; got != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.code, test.expected, got)
+		}
+	}
+	for code, l := range Locales {
+		if strings.Count(l.PercentPattern, "n") != 1 || strings.Count(l.PercentPattern, "%") != 1 {
+			t.Errorf("%s: invalid percent pattern %q", code, l.PercentPattern)
+		}
+	}
+}
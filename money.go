@@ -5,12 +5,11 @@
 package i18n
 
 import (
-	"bytes"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"strconv"
 	"strings"
 )
 
@@ -27,46 +26,143 @@ var (
 	ErrMoneyDivideByZero          = errors.New("i18n: money division by zero")
 	ErrMoneyDecimalPlacesTooLarge = errors.New("i18n: money decimal places too large")
 	ErrMoneyZeroOrLessChunks      = errors.New("i18n: cannot split money into zero or less chunks")
+	ErrMoneyCurrencyMismatch      = errors.New("i18n: money currency mismatch")
+	ErrMoneyNoRateSource          = errors.New("i18n: no currency rate source")
+	ErrMoneyInvalidNumber         = errors.New("i18n: invalid number")
+	ErrMoneyInvalidIncrement      = errors.New("i18n: money rounding increment must be greater than zero")
 )
 
+// MismatchPolicy specifies how arithmetic on two Money values of
+// different currencies behaves.
+type MismatchPolicy int
+
 const (
-	MAXDEC = 18
-	Round  = .5
-	Roundn = Round * -1
+	// MismatchFail rejects the operation with a *CurrencyMismatchError.
+	MismatchFail MismatchPolicy = iota
+	// MismatchConvert converts the second operand into the currency of the
+	// first one, using the rates from MoneyMath.Rates.
+	MismatchConvert
+	// MismatchIgnore combines the minor units of both values and keeps the
+	// currency of the first one. This is how older versions behaved.
+	MismatchIgnore
 )
 
-type moneyMarshalContainer struct {
-	M *int64       `json:"M"`
-	C CurrencyCode `json:"C"`
-	F *float64     `json:"F"`
+// MoneyMath is arithmetic on amounts of possibly different currencies,
+// which are combined according to Policy, e.g.
+//
+//	math := MoneyMath{Policy: MismatchConvert, Rates: rates}
+//	total, err := math.Add(Money{M: 1000, C: USD}, Money{M: 500, C: EUR})
+//
+// The methods of Money, BigMoney and ScaledMoney, and Sum, use the zero
+// MoneyMath, i.e. MismatchFail. A MoneyMath is safe for concurrent use if
+// its Rates are.
+type MoneyMath struct {
+	// Policy is applied when the currencies of both operands differ.
+	Policy MismatchPolicy
+	// Rates is the rate source used by MismatchConvert.
+	Rates RateSource
 }
 
-func (m Money) MarshalJSON() ([]byte, error) {
-	f := m.Get()
-	return json.Marshal(moneyMarshalContainer{M: &m.M, C: m.C, F: &f})
+// Add returns the sum of m and n, like Money.AddChecked.
+func (a MoneyMath) Add(m, n Money) (Money, error) {
+	n, err := a.operand(m, n)
+	if err != nil {
+		return Money{}, err
+	}
+	return m.add(n)
+}
+
+// Sub returns the result of subtracting n from m, like Money.SubChecked.
+func (a MoneyMath) Sub(m, n Money) (Money, error) {
+	n, err := a.operand(m, n)
+	if err != nil {
+		return Money{}, err
+	}
+	return m.sub(n)
 }
 
-func (m *Money) UnmarshalJSON(b []byte) error {
-	var container moneyMarshalContainer
-	err := json.Unmarshal(b, &container)
+// Mul returns the result of multiplying m by n, like Money.MulChecked.
+func (a MoneyMath) Mul(m, n Money) (Money, error) {
+	n, err := a.operand(m, n)
 	if err != nil {
-		return err
+		return Money{}, err
 	}
-	m.C = container.C
-	// prioritize the F value when unmarshalling over M
-	if container.F != nil {
-		*m = MakeMoney(m.C, *container.F)
-	} else if container.M != nil {
-		m.M = *container.M
+	return m.mul(n)
+}
+
+// Div returns the result of dividing m by n, like Money.DivChecked.
+func (a MoneyMath) Div(m, n Money) (Money, error) {
+	return a.DivRounded(m, n, RoundHalfCeiling)
+}
+
+// DivRounded is like Div, but rounds the result with the given mode.
+func (a MoneyMath) DivRounded(m, n Money, mode RoundingMode) (Money, error) {
+	n, err := a.operand(m, n)
+	if err != nil {
+		return Money{}, err
 	}
-	return nil
+	return m.div(n, mode)
 }
 
+// CurrencyMismatchError is returned when an operation is applied to two
+// Money values of different currencies. It matches ErrMoneyCurrencyMismatch
+// when used with errors.Is.
+type CurrencyMismatchError struct {
+	A CurrencyCode
+	B CurrencyCode
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("%v: %s and %s", ErrMoneyCurrencyMismatch, e.A, e.B)
+}
+
+// Is reports whether target is ErrMoneyCurrencyMismatch.
+func (e *CurrencyMismatchError) Is(target error) bool {
+	return target == ErrMoneyCurrencyMismatch
+}
+
+// Round and Roundn are the thresholds of the rounding that older versions
+// applied to float64 calculations. See RoundingMode for the rounding used today.
+const (
+	MAXDEC = 18
+	Round  = .5
+	Roundn = Round * -1
+)
+
+// MakeMoney returns amount in the given currency, rounded to its minor unit
+// with RoundHalfCeiling. Like Mulf, amount is taken as a decimal with
+// 15 significant digits.
+// It panics if amount is not a finite number or doesn't fit into a Money.
 func MakeMoney(currency CurrencyCode, amount float64) Money {
-	dpf := Money{C: currency}.dpf()
-	fDPf := amount * dpf
-	r := int64(amount * dpf)
-	return Money{C: currency, M: rnd(r, fDPf-float64(r))}
+	m, err := MakeMoneyRounded(currency, amount, RoundHalfCeiling)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// MakeMoneyRounded is like MakeMoney, but rounds with the given mode and
+// returns an error instead of panicking.
+func MakeMoneyRounded(currency CurrencyCode, amount float64, mode RoundingMode) (Money, error) {
+	r, err := floatRat(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	r.Mul(r, new(big.Rat).SetInt64(Money{C: currency}.dp()))
+	v, err := mode.roundRat(r)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{C: currency, M: v}, nil
+}
+
+// floatRat returns f as a decimal with 15 significant digits.
+func floatRat(f float64) (*big.Rat, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, ErrMoneyInvalidNumber
+	}
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', 15, 64))
+	return r, nil
 }
 
 // Returns the absolute value of Money.
@@ -78,30 +174,112 @@ func (m Money) Abs() Money {
 }
 
 // Adds two money types.
+// It panics if the result overflows or if the currencies of m and n differ.
 func (m Money) Add(n Money) Money {
+	r, err := m.AddChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// AddChecked is like Add, but returns an error instead of panicking.
+// Operands of different currencies return a *CurrencyMismatchError; an
+// empty currency is compatible with any other currency. Use MoneyMath to
+// combine different currencies.
+func (m Money) AddChecked(n Money) (Money, error) {
+	return MoneyMath{}.Add(m, n)
+}
+
+func (m Money) add(n Money) (Money, error) {
 	if m.C == "" {
 		m.C = n.C
 	}
 	r := m.M + n.M
 	if (r^m.M)&(r^n.M) < 0 {
-		panic(ErrMoneyOverflow)
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{C: m.C, M: r}, nil
+}
+
+// operand returns n in a form that can be combined with m, according to
+// a.Policy. An empty currency is compatible with any other currency.
+func (a MoneyMath) operand(m, n Money) (Money, error) {
+	rate, err := a.rate(m.C, n.C)
+	if err != nil || rate == nil {
+		return n, err
+	}
+	return n.convert(m.C, rate, RoundHalfCeiling)
+}
+
+// rate applies a.Policy to an operation on amounts in currencies x and y.
+// It returns the rate to convert y into x with, or nil if y can be used
+// as is.
+func (a MoneyMath) rate(x, y CurrencyCode) (*big.Rat, error) {
+	if x == "" || y == "" || x == y {
+		return nil, nil
 	}
-	return Money{C: m.C, M: r}
+	switch a.Policy {
+	case MismatchIgnore:
+		return nil, nil
+	case MismatchConvert:
+		if a.Rates == nil {
+			return nil, ErrMoneyNoRateSource
+		}
+		return a.Rates.Rate(y, x)
+	}
+	return nil, &CurrencyMismatchError{A: x, B: y}
+}
+
+// convert returns m converted into currency c, given the rate
+// to multiply an amount in m.C with to get the amount in c, rounded
+// to the minor unit of c with mode.
+func (m Money) convert(c CurrencyCode, rate *big.Rat, mode RoundingMode) (Money, error) {
+	r := new(big.Rat).SetInt64(m.M)
+	r.Mul(r, rate)
+	r.Mul(r, new(big.Rat).SetFrac64(Money{C: c}.dp(), m.dp()))
+	v, err := mode.roundRat(r)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{C: c, M: v}, nil
 }
 
 // Div returns the result of dividing m by n.
-// It panics if n has a zero value.
+// It panics if n has a zero value or if the currencies of m and n differ.
 func (m Money) Div(n Money) Money {
+	r, err := m.DivChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// DivChecked is like Div, but returns an error instead of panicking.
+// Operands of different currencies are handled like in AddChecked.
+func (m Money) DivChecked(n Money) (Money, error) {
+	return MoneyMath{}.Div(m, n)
+}
+
+// DivRounded is like DivChecked, but rounds the result with the given mode.
+func (m Money) DivRounded(n Money, mode RoundingMode) (Money, error) {
+	return MoneyMath{}.DivRounded(m, n, mode)
+}
+
+func (m Money) div(n Money, mode RoundingMode) (Money, error) {
 	if m.C == "" {
 		m.C = n.C
 	}
 	if n.M == 0 {
-		panic(ErrMoneyDivideByZero)
+		return Money{}, ErrMoneyDivideByZero
+	}
+	r := new(big.Rat).SetFrac(big.NewInt(m.M), big.NewInt(n.M))
+	r.Mul(r, new(big.Rat).SetInt64(m.dp()))
+	v, err := mode.roundRat(r)
+	if err != nil {
+		return Money{}, err
 	}
-	guardf := 100.0
-	f := guardf * m.dpf() * float64(m.M) / float64(n.M) / guardf
-	i := int64(f)
-	return Money{C: m.C, M: rnd(i, f-float64(i))}
+	return Money{C: m.C, M: v}, nil
 }
 
 // Split splits m into chunks parts.
@@ -111,35 +289,38 @@ func (m Money) Div(n Money) Money {
 // Money{C:"CAD",M:10}.Split(2) -> {5,5} // even split
 // Money{C:"CAD",M:10}.Split(3) -> {4,3,3} // split with remainder, larger values go first
 func (m Money) Split(chunks int64) []Money {
-	if chunks <= 0 {
-		panic(ErrMoneyZeroOrLessChunks)
+	result, err := m.SplitChecked(chunks)
+	if err != nil {
+		panic(err)
 	}
+	return result
+}
 
-	chunkAmount := m.M / chunks
-	remainder := m.M % chunks //remainder cannot be > chunks
-
-	var result []Money
-	for i := int64(0); i < chunks; i++ {
-		result = append(result, Money{M: chunkAmount, C: m.C})
+// SplitChecked is like Split, but returns an error instead of panicking.
+func (m Money) SplitChecked(chunks int64) ([]Money, error) {
+	if chunks <= 0 {
+		return nil, ErrMoneyZeroOrLessChunks
 	}
-
-	//give out missing cents starting from the top
-	for i := int64(0); i < remainder; i++ {
-		result[i] = result[i].Add(Money{M: 1, C: m.C})
+	ratios := make([]*big.Rat, chunks)
+	for i := range ratios {
+		ratios[i] = big.NewRat(1, 1)
 	}
-
-	return result
+	return m.AllocateRat(RemainderFirst, ratios...)
 }
 
 // rounding value, expressed as 10^N where N is the number of decimal places
 // (ie 2 decimals places == 10^2 == 100)
 func (m Money) dp() int64 {
-	for _, loc := range Locales {
-		if loc.CurrencyCode == m.C {
-			return int64(math.Pow10(loc.CurrencyDecimalDigits))
-		}
+	return int64(math.Pow10(m.digits()))
+}
+
+// number of decimal places of the currency, as of ISO 4217 (see Currency.Digits).
+// Unknown currencies use 2 decimal places.
+func (m Money) digits() int {
+	if curr, found := Currencies[m.C]; found {
+		return curr.Digits
 	}
-	return 100
+	return 2
 }
 
 // number of decimal places to use for rounding (float verison)
@@ -158,17 +339,97 @@ func (m Money) Get() float64 {
 }
 
 // Mul returns the result of multiplying m by n.
+// It panics if the result overflows or if the currencies of m and n differ.
 func (m Money) Mul(n Money) Money {
+	r, err := m.MulChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// MulChecked is like Mul, but returns an error instead of panicking.
+// Operands of different currencies are handled like in AddChecked.
+func (m Money) MulChecked(n Money) (Money, error) {
+	return MoneyMath{}.Mul(m, n)
+}
+
+func (m Money) mul(n Money) (Money, error) {
 	if m.C == "" {
 		m.C = n.C
 	}
-	return m.Mulf(n.Get())
+	return m.mulRat(new(big.Rat).SetFrac64(n.M, n.dp()), RoundHalfCeiling)
 }
 
 // Mulf is a convenience wrapper for m.Mul(MakeMoney(m.C, f))
+// f is taken as a decimal with 15 significant digits, which is the precision
+// a float64 can hold reliably, e.g. 2.26 rather than 2.2599999999999997868.
+// The multiplication itself is exact. Use MulRat or MulDecimalString to
+// avoid floats altogether.
+// It panics if f is not a finite number or the result overflows.
 func (m Money) Mulf(f float64) Money {
-	res, _ := big.NewFloat(m.Get()).SetMode(big.ToPositiveInf).Mul(big.NewFloat(m.Get()), big.NewFloat(f)).Float64()
-	return MakeMoney(m.C, res)
+	r, err := m.MulfChecked(f)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// MulfChecked is like Mulf, but returns an error instead of panicking.
+func (m Money) MulfChecked(f float64) (Money, error) {
+	return m.MulfRounded(f, RoundHalfCeiling)
+}
+
+// MulfRounded is like MulfChecked, but rounds the result with the given mode.
+func (m Money) MulfRounded(f float64, mode RoundingMode) (Money, error) {
+	r, err := floatRat(f)
+	if err != nil {
+		return Money{}, err
+	}
+	return m.mulRat(r, mode)
+}
+
+// MulRat returns the result of multiplying m by r. The multiplication
+// is exact and only the result is rounded to the minor unit of the currency.
+// It panics if the result overflows.
+func (m Money) MulRat(r *big.Rat) Money {
+	res, err := m.mulRat(r, RoundHalfCeiling)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// MulRatChecked is like MulRat, but returns an error instead of panicking.
+func (m Money) MulRatChecked(r *big.Rat) (Money, error) {
+	return m.mulRat(r, RoundHalfCeiling)
+}
+
+// MulRatRounded is like MulRatChecked, but rounds the result with the given mode.
+func (m Money) MulRatRounded(r *big.Rat, mode RoundingMode) (Money, error) {
+	return m.mulRat(r, mode)
+}
+
+// MulDecimalString returns the result of multiplying m by the decimal
+// number in s, e.g. "0.0825" for a tax rate of 8.25%. Like MulRat, the
+// multiplication is exact.
+func (m Money) MulDecimalString(s string) (Money, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrMoneyInvalidNumber, s)
+	}
+	return m.mulRat(r, RoundHalfCeiling)
+}
+
+// mulRat multiplies m by r and rounds the result with mode.
+func (m Money) mulRat(r *big.Rat, mode RoundingMode) (Money, error) {
+	res := new(big.Rat).SetInt64(m.M)
+	res.Mul(res, r)
+	v, err := mode.roundRat(res)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{C: m.C, M: v}, nil
 }
 
 // Neg returns a Money representing the negative value of m.
@@ -179,23 +440,57 @@ func (m Money) Neg() Money {
 	return m
 }
 
-// Rounds int64 remainder rounded half towards plus infinity
-// trunc = the remainder of the float64 calc
-// r     = the result of the int64 cal
-func rnd(r int64, trunc float64) int64 {
-	if trunc > 0 {
-		if trunc >= Round {
-			r++
-		}
-	} else {
-		if trunc < Roundn {
-			r--
-		}
+// Round returns m rounded to a multiple of increment, given in minor units
+// of the currency, e.g. Money{C: "CHF", M: 1234}.Round(RoundHalfUp, 5)
+// returns 12.35 CHF.
+// It panics if increment is zero or less, or the result overflows.
+func (m Money) Round(mode RoundingMode, increment int64) Money {
+	r, err := m.RoundChecked(mode, increment)
+	if err != nil {
+		panic(err)
 	}
 	return r
 }
 
+// RoundChecked is like Round, but returns an error instead of panicking.
+func (m Money) RoundChecked(mode RoundingMode, increment int64) (Money, error) {
+	if increment <= 0 {
+		return Money{}, ErrMoneyInvalidIncrement
+	}
+	q := mode.round(big.NewInt(m.M), big.NewInt(increment))
+	q.Mul(q, big.NewInt(increment))
+	if !q.IsInt64() {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{C: m.C, M: q.Int64()}, nil
+}
+
+// RoundCash returns m rounded to the cash increment of its currency
+// (see Currency.CashIncrement), half away from zero, e.g. 12.37 CHF
+// becomes 12.35 CHF. Currencies without a cash increment are returned as is.
+// Use FormatOptions.Cash to format the result with the cash digits of the
+// currency.
+// It panics if the result overflows.
+func (m Money) RoundCash() Money {
+	r, err := m.RoundCashChecked()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// RoundCashChecked is like RoundCash, but returns an error instead of
+// panicking.
+func (m Money) RoundCashChecked() (Money, error) {
+	curr, found := Currencies[m.C]
+	if !found || curr.CashIncrement <= 1 {
+		return m, nil
+	}
+	return m.RoundChecked(RoundHalfUp, curr.CashIncrement)
+}
+
 // Sign returns the sign of m: 1 if positive or zero, -1 if negative.
+// See Signum for a sign that is 0 for zero.
 func (m Money) Sign() int {
 	if m.M < 0 {
 		return -1
@@ -205,119 +500,226 @@ func (m Money) Sign() int {
 
 // String for money type representation in basic monetary unit (DOLLARS CENTS).
 func (m Money) String() string {
-	if m.Sign() > 0 {
-		return fmt.Sprintf("%d.%02d %s", m.Value()/m.dp(), m.Value()%m.dp(), m.C)
+	return m.decimal() + " " + string(m.C)
+}
+
+// decimal returns the amount of m as a decimal with the digits of the
+// currency, e.g. -1234.56.
+func (m Money) decimal() string {
+	return decimalString(big.NewInt(m.M), m.digits())
+}
+
+// decimalString returns v minor units as a decimal with the given number
+// of digits after the decimal point, e.g. -1234.56 for -123456 and 2.
+func decimalString(v *big.Int, digits int) string {
+	s := new(big.Int).Abs(v).String()
+	if digits > 0 {
+		if len(s) <= digits {
+			s = strings.Repeat("0", digits-len(s)+1) + s
+		}
+		s = s[:len(s)-digits] + "." + s[len(s)-digits:]
+	}
+	if v.Sign() < 0 {
+		return "-" + s
+	}
+	return s
+}
+
+// clampUint64 returns v as a uint64, or the largest uint64 if v is larger.
+func clampUint64(v *big.Int) uint64 {
+	if v.IsUint64() {
+		return v.Uint64()
 	}
-	// Negative value
-	return fmt.Sprintf("-%d.%02d %s", m.Abs().Value()/m.dp(), m.Abs().Value()%m.dp(), m.C)
+	return math.MaxUint64
 }
 
+// Format formats m for the given locale, e.g. 1.234,56 € for de_DE and
+// €1,234.56 for en_US. It is a shortcut for m.FormatWith(locale, FormatOptions{}).
 func (m Money) Format(locale string) string {
+	return m.FormatWith(locale, FormatOptions{})
+}
+
+// FormatWith formats m for the given locale, using the currency patterns
+// of the locale (see Locale.CurrencyPositivePattern and
+// Locale.CurrencyNegativePattern) and the given options.
+//
+// With any display other than DisplaySymbol, a space is inserted between
+// the currency and the number if the currency ends (or starts) with a letter
+// and the pattern has no space in between, e.g. USD 1,234.56 rather than
+// USD1,234.56. DisplayName shows the name after the number, regardless of
+// the pattern.
+func (m Money) FormatWith(locale string, opts FormatOptions) string {
 	l, found := Locales[locale]
 	if !found {
 		// If we don't have any information about the currency format,
 		// we'll try our best to display something useful.
 		return m.String()
 	}
+	v, digits := m.formatValue(opts)
+	return formatMoney(l, m.C, v, digits, opts)
+}
 
-	// DP is a measure for decimals: 2 decimal digits => dp = 10^2
-	currencySymbol := string(m.C)
+// formatValue returns the minor units of m and their number of decimal
+// digits, or the cash amount and the cash digits if opts.Cash is set.
+func (m Money) formatValue(opts FormatOptions) (*big.Int, int) {
+	v, digits := big.NewInt(m.M), m.digits()
 	curr, found := Currencies[m.C]
-	if found {
-		currencySymbol = curr.Symbol
+	if !opts.Cash || !found || curr.CashIncrement <= 1 {
+		return v, digits
 	}
+	// Round like RoundCash, but without overflowing.
+	increment := big.NewInt(curr.CashIncrement)
+	v = RoundHalfUp.round(v, increment)
+	v.Mul(v, increment)
+	if curr.CashDigits < digits {
+		v.Quo(v, pow10Int(digits-curr.CashDigits))
+		digits = curr.CashDigits
+	}
+	return v, digits
+}
+
+// formatMoney formats v units of currency c with the given number of
+// decimal digits for locale l (see Money.FormatWith). The number of digits
+// is at most MAXDEC.
+func formatMoney(l *Locale, c CurrencyCode, v *big.Int, digits int, opts FormatOptions) string {
+	s, _ := formatMoneyAligned(l, c, v, digits, opts)
+	return s
+}
+
+// formatMoneyAligned is like formatMoney, but also returns the byte offset
+// of the decimal separator in the result, or of the end of the number if
+// it has no decimals, to align amounts on (see FormatColumn).
+func formatMoneyAligned(l *Locale, c CurrencyCode, v *big.Int, digits int, opts FormatOptions) (string, int) {
+	m := Money{C: c}
 
 	// DP is a measure for decimals: 2 decimal digits => dp = 10^2
-	dp := int64(math.Pow10(l.CurrencyDecimalDigits))
-
-	// Group DP is a measure for grouping: 3 decimal digits => groupDp = 10^3
-	var groupDp int64
-	var groupSize int
-	if len(l.CurrencyGroupSizes) == 0 {
-		// BUG(oe): Handle currency group size
-		groupDp = int64(math.Pow10(3))
-		groupSize = 3
-	} else if len(l.CurrencyGroupSizes) >= 1 {
-		// BUG(oe): Handle currency group size
-		groupDp = int64(math.Pow10(l.CurrencyGroupSizes[0]))
-		groupSize = l.CurrencyGroupSizes[0]
-	}
-
-	// We use absolute values (as int64) from here on, because the
-	// negative sign is part of the currency format pattern.
-	absVal := m.Value()
-	if m.Sign() < 0 {
-		absVal = -absVal
+	// The number of decimals is a property of the currency, not the locale:
+	// a Yen amount has no decimals, even when formatted for Germany.
+	dp := int64(math.Pow10(digits))
+
+	// Group sizes follow the semantics of .NET (see groupDigits),
+	// e.g. {3, 2} for the Indian grouping of 1,23,45,678.
+	groupSizes := l.CurrencyGroupSizes
+	if len(groupSizes) == 0 {
+		groupSizes = []int{3}
 	}
-	wholeVal := absVal / dp
-	decVal := absVal % dp
 
-	// The unformatted string (without grouping and with a decimal sep of ".")
-	var unformatted string
-	if l.CurrencyDecimalDigits > 0 {
-		unformatted = fmt.Sprintf("%d.%0"+fmt.Sprintf("%d", l.CurrencyDecimalDigits)+"d", wholeVal, decVal)
+	// We use absolute values from here on, because the
+	// negative sign is part of the currency format pattern.
+	var (
+		negative, positive bool
+		wholeVal, decVal   uint64
+		whole, decimals    string
+	)
+	var compactOne bool
+	if opts.Compact {
+		r := new(big.Rat).SetFrac(v, big.NewInt(dp))
+		negative, whole, compactOne = formatCompact(r, opts.SignificantDigits, opts.Rounding, l.Language, l.CurrencyDecimalSeparator, groupSizes, l.CurrencyGroupSeparator)
+		positive = !negative && r.Sign() > 0
+	} else if opts.MinorUnits == MinorUnitsNever {
+		w := opts.Rounding.round(v, big.NewInt(dp))
+		negative, positive = w.Sign() < 0, w.Sign() > 0
+		w.Abs(w)
+		wholeVal = clampUint64(w)
+		whole = w.String()
 	} else {
-		unformatted = fmt.Sprintf("%d", wholeVal)
+		w, d := new(big.Int).QuoRem(new(big.Int).Abs(v), big.NewInt(dp), new(big.Int))
+		negative, positive = v.Sign() < 0, v.Sign() > 0
+		wholeVal = clampUint64(w)
+		decVal = d.Uint64()
+		whole = w.String()
+		if digits > 0 && (decVal != 0 || opts.MinorUnits != MinorUnitsOmitZero) {
+			decimals = fmt.Sprintf("%0*d", digits, decVal)
+		}
+	}
+	if !opts.Compact {
+		whole = groupDigits(whole, groupSizes, l.CurrencyGroupSeparator)
+	}
+
+	// Build formatted number from whole and decimal part
+	formatted := whole
+	if decimals != "" {
+		formatted += l.CurrencyDecimalSeparator + decimals
 	}
 
-	// Perform grouping operation of the whole number
-	// For 1234, this returns this array: [234 1]
-	groups := make([]string, 0)
-	for {
-		if groupDp > wholeVal {
-			// do not prepend zeros
-			groups = append(groups, fmt.Sprintf("%d", wholeVal%groupDp))
+	// The number is aligned on its decimal separator, or its last digit.
+	point := len(whole)
+	if opts.Compact {
+		if i := strings.Index(whole, l.CurrencyDecimalSeparator); i >= 0 {
+			point = i
 		} else {
-			// prepend zeros
-			groups = append(groups, fmt.Sprintf("%0"+fmt.Sprintf("%d", groupSize)+"d", wholeVal%groupDp))
-		}
-		wholeVal /= groupDp
-		if wholeVal == 0 {
-			break
+			point = strings.LastIndexAny(whole, "0123456789") + 1
 		}
 	}
-	var wholeBuf bytes.Buffer
-	for i := range groups {
-		if i > 0 {
-			wholeBuf.WriteString(l.CurrencyGroupSeparator)
+
+	if opts.Display == DisplayName {
+		pattern := "n"
+		if negative && opts.Accounting {
+			pattern = "(n)"
+		} else if negative {
+			pattern = l.NumberNegativePattern
+		} else if positive && opts.PlusSign {
+			pattern = "+n"
+		}
+		one := pluralOne(l.Language, wholeVal, decVal, decimals != "")
+		if opts.Compact {
+			one = compactOne
 		}
-		wholeBuf.WriteString(groups[len(groups)-i-1])
+		name := currencyName(l.Language, m.C, one)
+		r := strings.NewReplacer("n", formatted, "-", l.NegativeSign, "+", l.PositiveSign)
+		return applyWidth(pattern, r, " "+name, point, negative, opts)
 	}
 
 	// Which pattern do we need?
 	// Notice that the minus sign is part of the pattern
 	var pattern string
-	if m.Sign() > 0 {
-		pattern = l.CurrencyPositivePattern
-	} else {
+	switch {
+	case negative && opts.Accounting:
+		pattern = "(" + l.CurrencyPositivePattern + ")"
+	case negative:
 		pattern = l.CurrencyNegativePattern
+	case positive && opts.PlusSign:
+		pattern = plusPattern(l)
+	default:
+		pattern = l.CurrencyPositivePattern
 	}
 
-	// Split into whole and decimal and build formatted number
-	var formatted string
-	parts := strings.SplitN(unformatted, ".", 2)
-	if len(parts) > 1 {
-		formatted = fmt.Sprintf("%s%s%s", wholeBuf.String(), l.CurrencyDecimalSeparator, parts[1])
-	} else {
-		formatted = wholeBuf.String()
+	currencySymbol := m.currencyDisplay(l, opts.Display)
+	if opts.Display != DisplaySymbol {
+		pattern = currencySpacing(pattern, currencySymbol)
 	}
 
-	output := strings.Replace(pattern, "$", currencySymbol, -1)
-	output = strings.Replace(output, "n", formatted, -1)
-
-	return output
+	// Replace all placeholders in a single pass, as currency symbols
+	// may contain an "n" themselves (e.g. "man." or "kn").
+	r := strings.NewReplacer("$", currencySymbol, "n", formatted, "-", l.NegativeSign, "+", l.PositiveSign)
+	return applyWidth(pattern, r, "", point, negative, opts)
 }
 
 // Sub returns the result of subtracting n from m.
+// It panics if the result overflows or if the currencies of m and n differ.
 func (m Money) Sub(n Money) Money {
+	r, err := m.SubChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SubChecked is like Sub, but returns an error instead of panicking.
+// Operands of different currencies are handled like in AddChecked.
+func (m Money) SubChecked(n Money) (Money, error) {
+	return MoneyMath{}.Sub(m, n)
+}
+
+func (m Money) sub(n Money) (Money, error) {
 	if m.C == "" {
 		m.C = n.C
 	}
 	r := m.M - n.M
 	if (r^m.M)&^(r^n.M) < 0 {
-		panic(ErrMoneyOverflow)
+		return Money{}, ErrMoneyOverflow
 	}
-	return Money{C: m.C, M: r}
+	return Money{C: m.C, M: r}, nil
 }
 
 // Value returns in int64 the value of Money (also see Gett(), See Get() for float64).
@@ -2,7 +2,10 @@ package i18n
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
 	"testing"
 )
@@ -635,10 +638,10 @@ func TestMoneyFormat(t *testing.T) {
 		{Money{-1234567890, "HUF"}, "zh_CN", "Ft-12,345,678.90"},
 		{Money{1234567890, "JPY"}, "ja_JP", "¥1,234,567,890"},
 		{Money{-1234567890, "JPY"}, "ja_JP", "-¥1,234,567,890"},
-		{Money{1234567890, "JPY"}, "de_DE", "12.345.678,90 ¥"},
-		{Money{-1234567890, "JPY"}, "de_DE", "-12.345.678,90 ¥"},
-		{Money{1234567890, "JPY"}, "de_CH", "¥ 12'345'678.90"},
-		{Money{-1234567890, "JPY"}, "de_CH", "¥-12'345'678.90"},
+		{Money{1234567890, "JPY"}, "de_DE", "1.234.567.890 ¥"},
+		{Money{-1234567890, "JPY"}, "de_DE", "-1.234.567.890 ¥"},
+		{Money{1234567890, "JPY"}, "de_CH", "¥ 1'234'567'890"},
+		{Money{-1234567890, "JPY"}, "de_CH", "¥-1'234'567'890"},
 		{Money{1234567890, "SEK"}, "se_SE", "12.345.678,90 kr"},
 		{Money{-1234567890, "SEK"}, "se_SE", "-12.345.678,90 kr"},
 		{Money{1234567890, "SEK"}, "de_DE", "12.345.678,90 kr"},
@@ -647,6 +650,15 @@ func TestMoneyFormat(t *testing.T) {
 		{Money{-1234567890, "SEK"}, "de_CH", "kr-12'345'678.90"},
 		{Money{1234567890, "SEK"}, "zh_CN", "kr12,345,678.90"},
 		{Money{-1234567890, "SEK"}, "zh_CN", "kr-12,345,678.90"},
+		{Money{1234567890, "HRK"}, "hr_HR", "12.345.678,90 kn"},
+		{Money{1234567800, "INR"}, "hi_IN", "रु 1,23,45,678.00"},
+		{Money{-1234567800, "INR"}, "hi_IN", "रु -1,23,45,678.00"},
+		{Money{123456, "INR"}, "hi_IN", "रु 1,234.56"},
+		{Money{1234567890, "USD"}, "hi_IN", "$ 1,23,45,678.90"},
+		{Money{1234567890, "ETB"}, "am_ET", "ETB12345,678.90"},
+		{Money{-1234567890, "ETB"}, "am_ET", "-ETB12345,678.90"},
+		{Money{math.MinInt64, "EUR"}, "en_US", "(€92,233,720,368,547,758.08)"},
+		{Money{-1234567890, "HRK"}, "hr_HR", "-12.345.678,90 kn"},
 	}
 
 	for _, f := range fixtures {
@@ -665,7 +677,8 @@ func TestJSONUnmarshal(t *testing.T) {
 	}{
 		{`{"C": "CAD","M": 500}`, Money{C: "CAD", M: 500}, true},
 		{`{"C": "CAD","F": 5}`, Money{C: "CAD", M: 500}, true},
-		{`{"C": "CAD","F": 5, "M": 1}`, Money{C: "CAD", M: 500}, true},
+		{`{"C": "CAD","F": 5, "M": 500}`, Money{C: "CAD", M: 500}, true},
+		{`{"C": "CAD","F": 5, "M": 1}`, Money{}, false},
 	}
 	for _, test := range tests {
 		var m Money
@@ -679,3 +692,325 @@ func TestJSONUnmarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckedArithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func() (Money, error)
+		expected Money
+		err      error
+	}{
+		{"Add", func() (Money, error) { return Money{123, "EUR"}.AddChecked(Money{678, "EUR"}) }, Money{801, "EUR"}, nil},
+		{"Add empty currency", func() (Money, error) { return Money{}.AddChecked(Money{123, "EUR"}) }, Money{123, "EUR"}, nil},
+		{"Add overflow", func() (Money, error) { return Money{math.MaxInt64, "EUR"}.AddChecked(Money{1, "EUR"}) }, Money{}, ErrMoneyOverflow},
+		{"Add mismatch", func() (Money, error) { return Money{1, "USD"}.AddChecked(Money{1, "EUR"}) }, Money{}, ErrMoneyCurrencyMismatch},
+		{"Sub", func() (Money, error) { return Money{678, "EUR"}.SubChecked(Money{123, "EUR"}) }, Money{555, "EUR"}, nil},
+		{"Sub overflow", func() (Money, error) { return Money{math.MinInt64, "EUR"}.SubChecked(Money{1, "EUR"}) }, Money{}, ErrMoneyOverflow},
+		{"Sub mismatch", func() (Money, error) { return Money{1, "USD"}.SubChecked(Money{1, "EUR"}) }, Money{}, ErrMoneyCurrencyMismatch},
+		{"Mul", func() (Money, error) { return Money{123, "EUR"}.MulChecked(Money{200, "EUR"}) }, Money{246, "EUR"}, nil},
+		{"Mul overflow", func() (Money, error) { return Money{math.MaxInt64 / 2, "EUR"}.MulChecked(Money{300, "EUR"}) }, Money{}, ErrMoneyOverflow},
+		{"Mul mismatch", func() (Money, error) { return Money{1, "USD"}.MulChecked(Money{1, "EUR"}) }, Money{}, ErrMoneyCurrencyMismatch},
+		{"Mulf", func() (Money, error) { return Money{3390, "USD"}.MulfChecked(.75) }, Money{2543, "USD"}, nil},
+		{"Mulf overflow", func() (Money, error) { return Money{math.MaxInt64 / 2, "USD"}.MulfChecked(3) }, Money{}, ErrMoneyOverflow},
+		{"Div", func() (Money, error) { return Money{1000, "EUR"}.DivChecked(Money{300, "EUR"}) }, Money{333, "EUR"}, nil},
+		{"Div by zero", func() (Money, error) { return Money{1000, "EUR"}.DivChecked(Money{0, "EUR"}) }, Money{}, ErrMoneyDivideByZero},
+		{"Div mismatch", func() (Money, error) { return Money{1, "USD"}.DivChecked(Money{1, "EUR"}) }, Money{}, ErrMoneyCurrencyMismatch},
+	}
+	for _, test := range tests {
+		got, err := test.op()
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.err, err)
+			continue
+		}
+		if got != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestCurrencyMismatchError(t *testing.T) {
+	_, err := Money{1, "USD"}.AddChecked(Money{1, "EUR"})
+	var mismatch *CurrencyMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected a *CurrencyMismatchError, got %v", err)
+	}
+	if mismatch.A != USD || mismatch.B != EUR {
+		t.Errorf("expected currencies USD and EUR, got %s and %s", mismatch.A, mismatch.B)
+	}
+}
+
+func TestSplitChecked(t *testing.T) {
+	got, err := Money{700, "CAD"}.SplitChecked(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Money{{234, "CAD"}, {233, "CAD"}, {233, "CAD"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+	// The remainder of negative amounts is given out from the top too
+	got, err = Money{-10, "CAD"}.SplitChecked(4)
+	expected = []Money{{-3, "CAD"}, {-3, "CAD"}, {-2, "CAD"}, {-2, "CAD"}}
+	if err != nil || !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %s, got %s, %v", expected, got, err)
+	}
+	for _, m := range []Money{{-10, "CAD"}, {-7, "CAD"}, {math.MinInt64, "CAD"}} {
+		parts, _ := m.SplitChecked(3)
+		if sum, err := Sum(parts...); err != nil || sum != m {
+			t.Errorf("%v: expected the parts to add up, got %v, %v", m, sum, err)
+		}
+		if b := (BigMoney{M: big.NewInt(m.M), C: m.C}).Split(3); b[0].M.Int64() != parts[0].M || b[2].M.Int64() != parts[2].M {
+			t.Errorf("%v: expected the parts of BigMoney.Split %v, got %v", m, b, parts)
+		}
+	}
+	if _, err := (Money{700, "CAD"}).SplitChecked(0); !errors.Is(err, ErrMoneyZeroOrLessChunks) {
+		t.Errorf("expected %v, got %v", ErrMoneyZeroOrLessChunks, err)
+	}
+}
+
+type testRates map[CurrencyCode]map[CurrencyCode]*big.Rat
+
+func (r testRates) Rate(from, to CurrencyCode) (*big.Rat, error) {
+	if rate, found := r[from][to]; found {
+		return rate, nil
+	}
+	return nil, fmt.Errorf("no rate from %s to %s", from, to)
+}
+
+func TestMoneyMath(t *testing.T) {
+	usd := Money{1000, "USD"}
+	eur := Money{500, "EUR"}
+
+	if _, err := usd.AddChecked(eur); !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected %v, got %v", ErrMoneyCurrencyMismatch, err)
+	}
+	if _, err := (MoneyMath{}).Add(usd, eur); !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected %v, got %v", ErrMoneyCurrencyMismatch, err)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("expected Add to panic on mismatched currencies")
+			}
+		}()
+		usd.Add(eur)
+	}()
+
+	ignore := MoneyMath{Policy: MismatchIgnore}
+	if got, err := ignore.Add(usd, eur); err != nil || got != (Money{1500, "USD"}) {
+		t.Errorf("expected %v, got %v, %v", Money{1500, "USD"}, got, err)
+	}
+
+	convert := MoneyMath{Policy: MismatchConvert}
+	if _, err := convert.Add(usd, eur); err != ErrMoneyNoRateSource {
+		t.Errorf("expected %v, got %v", ErrMoneyNoRateSource, err)
+	}
+	convert.Rates = testRates{
+		EUR: {USD: big.NewRat(11, 10), JPY: big.NewRat(1301, 10)},
+	}
+	tests := []struct {
+		op       func(m, n Money) (Money, error)
+		m, n     Money
+		expected Money
+	}{
+		{convert.Add, usd, eur, Money{1550, "USD"}},
+		{convert.Sub, usd, eur, Money{450, "USD"}},
+		{convert.Mul, usd, eur, Money{5500, "USD"}},
+		{convert.Div, usd, eur, Money{182, "USD"}},
+		{convert.Add, Money{100, "JPY"}, Money{333, "EUR"}, Money{533, "JPY"}},
+	}
+	for i, test := range tests {
+		if got, err := test.op(test.m, test.n); err != nil || got != test.expected {
+			t.Errorf("%d: %v, %v: expected %v, got %v, %v", i, test.m, test.n, test.expected, got, err)
+		}
+	}
+	if _, err := convert.Add(eur, usd); err == nil {
+		t.Errorf("expected an error for a missing rate")
+	}
+	// The policy of a MoneyMath doesn't affect other arithmetic
+	if _, err := usd.AddChecked(eur); !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected %v, got %v", ErrMoneyCurrencyMismatch, err)
+	}
+}
+
+func TestMulRat(t *testing.T) {
+	tests := []struct {
+		money    Money
+		rate     *big.Rat
+		expected Money
+	}{
+		{Money{10000, "USD"}, big.NewRat(825, 10000), Money{825, "USD"}},
+		{Money{1999, "USD"}, big.NewRat(825, 10000), Money{165, "USD"}},
+		{Money{-1999, "USD"}, big.NewRat(825, 10000), Money{-165, "USD"}},
+		{Money{5667, "USD"}, big.NewRat(1, 6), Money{945, "USD"}},
+		{Money{9007199254740993, "USD"}, big.NewRat(1, 1), Money{9007199254740993, "USD"}},
+		{Money{1234, JPY}, big.NewRat(1, 2), Money{617, JPY}},
+	}
+	for _, test := range tests {
+		if got := test.money.MulRat(test.rate); got != test.expected {
+			t.Errorf("%v * %v: expected %v, got %v", test.money, test.rate, test.expected, got)
+		}
+	}
+	if _, err := (Money{math.MaxInt64, "USD"}).MulRatChecked(big.NewRat(2, 1)); err != ErrMoneyOverflow {
+		t.Errorf("expected %v, got %v", ErrMoneyOverflow, err)
+	}
+}
+
+func TestMulDecimalString(t *testing.T) {
+	tests := []struct {
+		money    Money
+		rate     string
+		expected Money
+		err      error
+	}{
+		{Money{10000, "USD"}, "0.0825", Money{825, "USD"}, nil},
+		{Money{1999, "USD"}, "1.0825", Money{2164, "USD"}, nil},
+		{Money{4611686018427387903, "USD"}, "2", Money{9223372036854775806, "USD"}, nil},
+		{Money{100, "USD"}, "abc", Money{}, ErrMoneyInvalidNumber},
+		{Money{100, "USD"}, "", Money{}, ErrMoneyInvalidNumber},
+	}
+	for _, test := range tests {
+		got, err := test.money.MulDecimalString(test.rate)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%v * %q: expected error %v, got %v", test.money, test.rate, test.err, err)
+			continue
+		}
+		if got != test.expected {
+			t.Errorf("%v * %q: expected %v, got %v", test.money, test.rate, test.expected, got)
+		}
+	}
+}
+
+func TestDivLargeAmounts(t *testing.T) {
+	got := Money{9007199254740993, "USD"}.Div(Money{100, "USD"})
+	if expected := (Money{9007199254740993, "USD"}); got != expected {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if _, err := (Money{math.MaxInt64, "USD"}).DivChecked(Money{1, "USD"}); err != ErrMoneyOverflow {
+		t.Errorf("expected %v, got %v", ErrMoneyOverflow, err)
+	}
+}
+
+func TestMoneyRound(t *testing.T) {
+	tests := []struct {
+		money     Money
+		mode      RoundingMode
+		increment int64
+		expected  Money
+	}{
+		{Money{1234, "CHF"}, RoundHalfCeiling, 5, Money{1235, "CHF"}},
+		{Money{1232, "CHF"}, RoundHalfCeiling, 5, Money{1230, "CHF"}},
+		{Money{1237, "CHF"}, RoundHalfCeiling, 5, Money{1235, "CHF"}},
+		{Money{1238, "CHF"}, RoundHalfCeiling, 5, Money{1240, "CHF"}},
+		{Money{-1232, "CHF"}, RoundHalfCeiling, 5, Money{-1230, "CHF"}},
+		{Money{1250, "EUR"}, RoundHalfEven, 100, Money{1200, "EUR"}},
+		{Money{1350, "EUR"}, RoundHalfEven, 100, Money{1400, "EUR"}},
+		{Money{1299, "EUR"}, RoundTruncate, 100, Money{1200, "EUR"}},
+		{Money{-1299, "EUR"}, RoundFloor, 100, Money{-1300, "EUR"}},
+		{Money{1201, "EUR"}, RoundCeiling, 100, Money{1300, "EUR"}},
+		{Money{1201, "EUR"}, RoundHalfDown, 1, Money{1201, "EUR"}},
+	}
+	for _, test := range tests {
+		if got := test.money.Round(test.mode, test.increment); got != test.expected {
+			t.Errorf("%v.Round(%v, %d): expected %v, got %v", test.money, test.mode, test.increment, test.expected, got)
+		}
+	}
+	if _, err := (Money{1234, "CHF"}).RoundChecked(RoundHalfUp, 0); !errors.Is(err, ErrMoneyInvalidIncrement) {
+		t.Errorf("expected %v, got %v", ErrMoneyInvalidIncrement, err)
+	}
+	if _, err := (Money{math.MaxInt64, "CHF"}).RoundChecked(RoundCeiling, 100); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("expected %v, got %v", ErrMoneyOverflow, err)
+	}
+	if got, err := (Money{1237, "CHF"}).RoundCashChecked(); err != nil || got != (Money{1235, "CHF"}) {
+		t.Errorf("expected 12.35 CHF, got %v, %v", got, err)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r != ErrMoneyInvalidIncrement {
+				t.Errorf("expected Round to panic with %v, got %v", ErrMoneyInvalidIncrement, r)
+			}
+		}()
+		Money{1234, "CHF"}.Round(RoundHalfUp, -5)
+	}()
+}
+
+func TestRoundedOperations(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func() (Money, error)
+		expected Money
+	}{
+		{"MakeMoney default", func() (Money, error) { return MakeMoneyRounded("EUR", 0.125, RoundHalfCeiling) }, Money{13, "EUR"}},
+		{"MakeMoney half even", func() (Money, error) { return MakeMoneyRounded("EUR", 0.125, RoundHalfEven) }, Money{12, "EUR"}},
+		{"MakeMoney truncate", func() (Money, error) { return MakeMoneyRounded("EUR", -0.129, RoundTruncate) }, Money{-12, "EUR"}},
+		{"MakeMoney decimal", func() (Money, error) { return MakeMoneyRounded("EUR", 1.005, RoundHalfUp) }, Money{101, "EUR"}},
+		{"Mulf half even", func() (Money, error) { return Money{3390, "USD"}.MulfRounded(.75, RoundHalfEven) }, Money{2542, "USD"}},
+		{"Mulf floor", func() (Money, error) { return Money{5668, "USD"}.MulfRounded(.5, RoundFloor) }, Money{2834, "USD"}},
+		{"MulRat half down", func() (Money, error) { return Money{5, "USD"}.MulRatRounded(big.NewRat(1, 2), RoundHalfDown) }, Money{2, "USD"}},
+		{"Div half even", func() (Money, error) { return Money{22668, "USD"}.DivRounded(Money{2400, "USD"}, RoundHalfEven) }, Money{944, "USD"}},
+		{"Div ceiling", func() (Money, error) { return Money{1000, "USD"}.DivRounded(Money{300, "USD"}, RoundCeiling) }, Money{334, "USD"}},
+	}
+	for _, test := range tests {
+		got, err := test.op()
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if got != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+	if _, err := MakeMoneyRounded("EUR", math.NaN(), RoundHalfEven); err != ErrMoneyInvalidNumber {
+		t.Errorf("expected %v, got %v", ErrMoneyInvalidNumber, err)
+	}
+}
+
+func TestRoundCash(t *testing.T) {
+	tests := []struct {
+		money    Money
+		expected Money
+	}{
+		{Money{1237, "CHF"}, Money{1235, "CHF"}},
+		{Money{1238, "CHF"}, Money{1240, "CHF"}},
+		{Money{1232, "CHF"}, Money{1230, "CHF"}},
+		{Money{-1238, "CHF"}, Money{-1240, "CHF"}},
+		{Money{1999, "CAD"}, Money{2000, "CAD"}},
+		{Money{1249, "SEK"}, Money{1200, "SEK"}},
+		{Money{1250, "SEK"}, Money{1300, "SEK"}},
+		{Money{1224, "DKK"}, Money{1200, "DKK"}},
+		{Money{1225, "DKK"}, Money{1250, "DKK"}},
+		{Money{1237, "EUR"}, Money{1237, "EUR"}},
+		{Money{1237, "XYZ"}, Money{1237, "XYZ"}},
+	}
+	for _, test := range tests {
+		if got := test.money.RoundCash(); got != test.expected {
+			t.Errorf("%v: expected %v, got %v", test.money, test.expected, got)
+		}
+	}
+}
+
+func TestMoneyDecimalPlaces(t *testing.T) {
+	var fixtures = []struct {
+		m        Money
+		dp       int64
+		expected string
+	}{
+		{Money{123456, "EUR"}, 100, "1234.56 EUR"},
+		{Money{123456, "JPY"}, 1, "123456 JPY"},
+		{Money{-123456, "JPY"}, 1, "-123456 JPY"},
+		{Money{123456, "KWD"}, 1000, "123.456 KWD"},
+		{Money{-5, "BHD"}, 1000, "-0.005 BHD"},
+		{Money{123456, "CLF"}, 10000, "12.3456 CLF"},
+		{Money{123456, "XAU"}, 100, "1234.56 XAU"},
+		{Money{123456, "XYZ"}, 100, "1234.56 XYZ"},
+		{Money{math.MinInt64, "EUR"}, 100, "-92233720368547758.08 EUR"},
+	}
+	for _, f := range fixtures {
+		if got := f.m.dp(); got != f.dp {
+			t.Errorf("%s: expected dp %d, got %d", f.m.C, f.dp, got)
+		}
+		if got := f.m.String(); got != f.expected {
+			t.Errorf("expected %s, got %s", f.expected, got)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrUnsupportedNumber is returned when formatting a value that is not a number.
+var ErrUnsupportedNumber = errors.New("i18n: unsupported number type")
+
+// LocaleDigits can be used as NumberOptions.Digits to use the number of
+// decimal digits of the locale (see Locale.NumberDecimalDigits).
+const LocaleDigits = -1
+
+// NumberOptions controls how FormatNumber, FormatPercent and FormatPerMille
+// format a number. A nil *NumberOptions uses the locale's number of decimal
+// digits, groups the integer part and rounds with RoundHalfCeiling.
+type NumberOptions struct {
+	// Digits is the number of digits after the decimal separator,
+	// or LocaleDigits to use the number of digits of the locale.
+	Digits int
+	// TrimZeros removes trailing zeros after the decimal separator,
+	// e.g. 1.50 becomes 1.5 and 2.00 becomes 2.
+	TrimZeros bool
+	// NoGrouping disables grouping of the integer part, e.g. 1234.5
+	// rather than 1,234.5.
+	NoGrouping bool
+	// Rounding is the mode used to round to Digits.
+	Rounding RoundingMode
+	// Compact abbreviates large numbers with the suffixes of the language
+	// (see Language.CompactSuffixes), e.g. 1.2K for 1234 in English and
+	// 1,2 Tsd. in German. Digits and TrimZeros are ignored.
+	Compact bool
+	// SignificantDigits is the number of significant digits of compact
+	// numbers, or 0 for DefaultSignificantDigits. Digits of the integer
+	// part are never rounded away, e.g. 123456 is 123K.
+	SignificantDigits int
+}
+
+// invariantLocale is used for locales we know nothing about.
+var invariantLocale = &Locale{
+	NegativeSign:           "-",
+	PositiveSign:           "+",
+	PercentPattern:         "n%",
+	PercentSymbol:          "%",
+	PerMilleSymbol:         "‰",
+	NaNSymbol:              "NaN",
+	NumberDecimalDigits:    2,
+	NumberDecimalSeparator: ".",
+	NumberGroupSizes:       []int{3},
+	NumberGroupSeparator:   ",",
+	NumberNegativePattern:  "-n",
+}
+
+// FormatNumber formats value as a plain number for the given locale, e.g.
+// 1.234.567,89 for de_DE and 1,234,567.89 for en_US. value can be any
+// integer or float type, a *big.Int, *big.Rat or *big.Float, or a decimal
+// string such as "1234.5678" that is formatted without loss of precision.
+// If the locale is unknown, 1,234,567.89 is used.
+func FormatNumber(locale string, value interface{}, opts *NumberOptions) (string, error) {
+	return formatNumber(locale, value, opts, 1, "")
+}
+
+// FormatPercent is like FormatNumber, but formats value as a percentage,
+// e.g. 0.256 becomes 25.60%.
+func FormatPercent(locale string, value interface{}, opts *NumberOptions) (string, error) {
+	l := numberLocale(locale)
+	return formatNumber(locale, value, opts, 100, l.PercentSymbol)
+}
+
+// FormatPerMille is like FormatNumber, but formats value in per mille,
+// e.g. 0.0256 becomes 25.60‰.
+func FormatPerMille(locale string, value interface{}, opts *NumberOptions) (string, error) {
+	l := numberLocale(locale)
+	return formatNumber(locale, value, opts, 1000, l.PerMilleSymbol)
+}
+
+func numberLocale(locale string) *Locale {
+	if l, found := Locales[locale]; found {
+		return l
+	}
+	return invariantLocale
+}
+
+func formatNumber(locale string, value interface{}, opts *NumberOptions, factor int64, symbol string) (string, error) {
+	l := numberLocale(locale)
+	if opts == nil {
+		opts = &NumberOptions{Digits: LocaleDigits}
+	}
+	r, special, err := numberRat(value)
+	if err != nil {
+		return "", err
+	}
+	if special != 0 {
+		// The infinity sign is the same in all locales, so it isn't part
+		// of the locale data.
+		switch {
+		case math.IsNaN(special):
+			return l.NaNSymbol, nil
+		case special > 0:
+			return "∞", nil
+		default:
+			return l.NegativeSign + "∞", nil
+		}
+	}
+	r.Mul(r, new(big.Rat).SetInt64(factor))
+
+	digits := opts.Digits
+	if digits < 0 {
+		digits = l.NumberDecimalDigits
+	}
+	sep := l.NumberGroupSeparator
+	if opts.NoGrouping {
+		sep = ""
+	}
+	var (
+		negative bool
+		number   string
+	)
+	if opts.Compact {
+		negative, number, _ = formatCompact(r, opts.SignificantDigits, opts.Rounding, l.Language, l.NumberDecimalSeparator, l.NumberGroupSizes, sep)
+	} else {
+		negative, number = formatRat(r, digits, opts.Rounding, opts.TrimZeros, l.NumberDecimalSeparator, l.NumberGroupSizes, sep)
+	}
+
+	if symbol != "" {
+		number = percentPattern(l, number, symbol)
+	}
+	if !negative {
+		return number, nil
+	}
+	pattern := l.NumberNegativePattern
+	if pattern == "" {
+		pattern = "-n"
+	}
+	return strings.NewReplacer("-", l.NegativeSign, "n", number).Replace(pattern), nil
+}
+
+// percentPattern adds the percent (or per mille) symbol to number, as the
+// PercentPattern of the locale specifies, e.g. 25 % in German and %25 in
+// Turkish.
+func percentPattern(l *Locale, number, symbol string) string {
+	pattern := l.PercentPattern
+	if pattern == "" {
+		pattern = "n%"
+	}
+	return strings.NewReplacer("n", number, "%", symbol).Replace(pattern)
+}
+
+// numberRat converts value to a *big.Rat. For floats that are NaN or
+// infinite, it returns the float as special instead.
+func numberRat(value interface{}) (r *big.Rat, special float64, err error) {
+	switch v := value.(type) {
+	case int:
+		return new(big.Rat).SetInt64(int64(v)), 0, nil
+	case int8:
+		return new(big.Rat).SetInt64(int64(v)), 0, nil
+	case int16:
+		return new(big.Rat).SetInt64(int64(v)), 0, nil
+	case int32:
+		return new(big.Rat).SetInt64(int64(v)), 0, nil
+	case int64:
+		return new(big.Rat).SetInt64(v), 0, nil
+	case uint:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(uint64(v))), 0, nil
+	case uint8:
+		return new(big.Rat).SetInt64(int64(v)), 0, nil
+	case uint16:
+		return new(big.Rat).SetInt64(int64(v)), 0, nil
+	case uint32:
+		return new(big.Rat).SetInt64(int64(v)), 0, nil
+	case uint64:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(v)), 0, nil
+	case float32:
+		return floatNumberRat(float64(v), 32)
+	case float64:
+		return floatNumberRat(v, 64)
+	case *big.Int:
+		return new(big.Rat).SetInt(v), 0, nil
+	case *big.Rat:
+		return new(big.Rat).Set(v), 0, nil
+	case *big.Float:
+		if v.IsInf() {
+			if v.Sign() < 0 {
+				return nil, math.Inf(-1), nil
+			}
+			return nil, math.Inf(1), nil
+		}
+		r, _ := v.Rat(nil)
+		return r, 0, nil
+	case string:
+		r, ok := new(big.Rat).SetString(v)
+		if !ok {
+			return nil, 0, fmt.Errorf("%w: %q", ErrMoneyInvalidNumber, v)
+		}
+		return r, 0, nil
+	}
+	return nil, 0, fmt.Errorf("%w: %T", ErrUnsupportedNumber, value)
+}
+
+// floatNumberRat converts f to the shortest decimal that represents it,
+// so that 2.675 is formatted as 2.68 rather than 2.67.
+func floatNumberRat(f float64, bitSize int) (*big.Rat, float64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, f, nil
+	}
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, bitSize))
+	return r, 0, nil
+}
+
+// formatRat formats the absolute value of r with the given number of
+// digits after the decimal separator. It reports whether the formatted
+// value is negative, i.e. r is negative and not rounded to zero.
+func formatRat(r *big.Rat, digits int, mode RoundingMode, trimZeros bool, decimalSep string, groupSizes []int, groupSep string) (bool, string) {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10Int(digits)))
+	v := mode.round(scaled.Num(), scaled.Denom())
+	negative := v.Sign() < 0
+	s := new(big.Int).Abs(v).String()
+	if len(s) <= digits {
+		s = strings.Repeat("0", digits-len(s)+1) + s
+	}
+	whole, frac := s[:len(s)-digits], s[len(s)-digits:]
+	if trimZeros {
+		frac = strings.TrimRight(frac, "0")
+	}
+	whole = groupDigits(whole, groupSizes, groupSep)
+	if frac == "" {
+		return negative, whole
+	}
+	return negative, whole + decimalSep + frac
+}
+
+// groupDigits inserts sep into the string of digits s, using the semantics of
+// .NET's NumberGroupSizes: sizes lists the sizes of the groups from the
+// decimal separator to the left. The last size repeats for the remaining
+// digits, unless it is 0, in which case the remaining digits aren't grouped.
+// E.g. {3} yields 123,456,789, {3, 2} yields 12,34,56,789, and {3, 0}
+// yields 123456,789.
+func groupDigits(s string, sizes []int, sep string) string {
+	if sep == "" || len(sizes) == 0 {
+		return s
+	}
+	var groups []string
+	i, size := 0, 0
+	for end := len(s); end > 0; {
+		if i < len(sizes) {
+			size = sizes[i]
+			i++
+		}
+		if size <= 0 || size >= end {
+			groups = append(groups, s[:end])
+			break
+		}
+		groups = append(groups, s[end-size:end])
+		end -= size
+	}
+	var b strings.Builder
+	for i := len(groups) - 1; i >= 0; i-- {
+		b.WriteString(groups[i])
+		if i > 0 {
+			b.WriteString(sep)
+		}
+	}
+	return b.String()
+}
+
+// pow10Int returns 10^n.
+func pow10Int(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,126 @@
+package i18n
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		locale   string
+		value    interface{}
+		opts     *NumberOptions
+		expected string
+	}{
+		{"en_US", 1234567, nil, "1,234,567.00"},
+		{"en_US", int64(-1234567), nil, "-1,234,567.00"},
+		{"en_US", uint64(math.MaxUint64), &NumberOptions{}, "18,446,744,073,709,551,615"},
+		{"en_US", 1234.5678, nil, "1,234.57"},
+		{"en_US", 2.675, nil, "2.68"},
+		{"en_US", float32(0.1), &NumberOptions{Digits: 3}, "0.100"},
+		{"en_US", -0.001, nil, "0.00"},
+		{"en_US", 1234.5, &NumberOptions{Digits: 4, TrimZeros: true}, "1,234.5"},
+		{"en_US", 1234.5, &NumberOptions{Digits: LocaleDigits, NoGrouping: true}, "1234.50"},
+		{"en_US", 2.5, &NumberOptions{Rounding: RoundHalfEven}, "2"},
+		{"en_US", "12345678901234567890.125", nil, "12,345,678,901,234,567,890.13"},
+		{"en_US", big.NewInt(-1000), &NumberOptions{}, "-1,000"},
+		{"en_US", big.NewRat(1, 3), &NumberOptions{Digits: 5}, "0.33333"},
+		{"en_US", big.NewFloat(1.5), nil, "1.50"},
+		{"de_DE", 1234567.891, nil, "1.234.567,89"},
+		{"de_DE", -1234567.891, nil, "-1.234.567,89"},
+		{"de_CH", 1234567.891, nil, "1'234'567.89"},
+		{"fr_FR", 1234567.891, nil, "1\u00a0234\u00a0567,89"},
+		{"hi_IN", 12345678, &NumberOptions{}, "1,23,45,678"},
+		{"am_ET", 12345678.9, nil, "12345,678.9"},
+		{"ar_SA", -12.5, nil, "12.50-"},
+		{"xx_XX", -1234.5, nil, "-1,234.50"},
+		{"en_US", math.NaN(), nil, "NaN"},
+		{"de_DE", math.NaN(), nil, "n. def."},
+		{"en_US", math.Inf(-1), nil, "-∞"},
+	}
+	for _, test := range tests {
+		got, err := FormatNumber(test.locale, test.value, test.opts)
+		if err != nil {
+			t.Errorf("%s: %v: unexpected error %v", test.locale, test.value, err)
+			continue
+		}
+		if got != test.expected {
+			t.Errorf("%s: %v: expected %q, got %q", test.locale, test.value, test.expected, got)
+		}
+	}
+}
+
+func TestFormatNumberErrors(t *testing.T) {
+	if _, err := FormatNumber("en_US", struct{}{}, nil); !errors.Is(err, ErrUnsupportedNumber) {
+		t.Errorf("expected %v, got %v", ErrUnsupportedNumber, err)
+	}
+	if _, err := FormatNumber("en_US", "12,5", nil); !errors.Is(err, ErrMoneyInvalidNumber) {
+		t.Errorf("expected %v, got %v", ErrMoneyInvalidNumber, err)
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	tests := []struct {
+		locale   string
+		value    interface{}
+		opts     *NumberOptions
+		expected string
+	}{
+		{"en_US", 0.256, nil, "25.60%"},
+		{"en_US", 0.256, &NumberOptions{Digits: 1}, "25.6%"},
+		{"en_US", -0.5, &NumberOptions{}, "-50%"},
+		{"en_US", 12.5, &NumberOptions{}, "1,250%"},
+		{"de_DE", 0.256, &NumberOptions{}, "26 %"},
+		{"fr_FR", "0.0825", nil, "8,25 %"},
+		{"de_CH", 0.256, &NumberOptions{}, "26%"},
+		{"tr_TR", 0.25, &NumberOptions{}, "%25"},
+		{"tr_TR", -0.25, &NumberOptions{}, "-%25"},
+		{"eu_ES", 0.25, &NumberOptions{}, "% 25"},
+		{"xx_XX", 0.256, nil, "25.60%"},
+	}
+	for _, test := range tests {
+		got, err := FormatPercent(test.locale, test.value, test.opts)
+		if err != nil {
+			t.Errorf("%s: %v: unexpected error %v", test.locale, test.value, err)
+			continue
+		}
+		if got != test.expected {
+			t.Errorf("%s: %v: expected %q, got %q", test.locale, test.value, test.expected, got)
+		}
+	}
+}
+
+func TestFormatPerMille(t *testing.T) {
+	got, err := FormatPerMille("en_US", 0.0256, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "25.60‰"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestGroupDigits(t *testing.T) {
+	tests := []struct {
+		digits   string
+		sizes    []int
+		expected string
+	}{
+		{"1", []int{3}, "1"},
+		{"123", []int{3}, "123"},
+		{"1234", []int{3}, "1,234"},
+		{"123456789", []int{3}, "123,456,789"},
+		{"12345678", []int{3, 2}, "1,23,45,678"},
+		{"123456789", []int{3, 0}, "123456,789"},
+		{"123456789", []int{1, 2, 3}, "123,456,78,9"},
+		{"123456789", []int{0}, "123456789"},
+		{"123456789", nil, "123456789"},
+	}
+	for _, test := range tests {
+		if got := groupDigits(test.digits, test.sizes, ","); got != test.expected {
+			t.Errorf("%s %v: expected %s, got %s", test.digits, test.sizes, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,519 @@
+package i18n
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseError describes a string that cannot be parsed into a Money.
+type ParseError struct {
+	// Input is the string that was parsed.
+	Input string
+	// Offset is the byte offset in Input where the problem was found.
+	Offset int
+	// Reason describes the problem.
+	Reason string
+	// Err is the underlying error, if any, e.g. ErrMoneyOverflow.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("i18n: cannot parse %q at offset %d: %s", e.Input, e.Offset, e.Reason)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseMoney parses a money string formatted for the given locale, e.g.
+// "1.234,56 €" for de_DE or "($1,234.56)" for en_US. It is the inverse
+// of Money.Format, but is lenient with respect to user input: the sign,
+// the currency and the number may appear in any order, grouping is optional
+// and fewer decimals than the currency has are accepted. If the number is
+// grouped, the groups must have the sizes of the locale, so that e.g.
+// "12,50" is rejected for en_US rather than taken for 1250.
+//
+// The currency is identified by its ISO code or by its symbol. If a symbol
+// is used by several currencies, the currency of the locale wins, then the
+// currency most commonly meant by the symbol, e.g. USD for "$". If the
+// string contains no currency at all, the currency of the locale is used.
+//
+// For unknown locales, the format of Money.String is expected,
+// e.g. "-1234.56 EUR".
+//
+// Errors are of type *ParseError.
+func ParseMoney(locale, s string) (Money, error) {
+	return ParseMoneyIn(locale, "", s)
+}
+
+// ParseMoneyIn is like ParseMoney, but parses an amount in the given currency.
+// It resolves ambiguous symbols in favour of currency and uses currency if s
+// contains no currency at all. It is an error if s contains a different currency.
+func ParseMoneyIn(locale string, currency CurrencyCode, s string) (Money, error) {
+	p := newMoneyParser(locale, s)
+	return p.parse(currency)
+}
+
+// moneyParser holds the state of parsing a single money string.
+type moneyParser struct {
+	input   string
+	locale  *Locale
+	decimal string
+	groups  []string
+	sizes   []int
+	// s is input without formatting characters such as the right-to-left
+	// mark, and offsets maps byte offsets of s to byte offsets of input.
+	s       string
+	offsets []int
+}
+
+func newMoneyParser(locale, input string) *moneyParser {
+	p := &moneyParser{input: input, decimal: ".", groups: []string{","}, sizes: []int{3}}
+	if l, found := Locales[locale]; found {
+		p.locale = l
+		p.decimal = l.CurrencyDecimalSeparator
+		p.groups = []string{l.CurrencyGroupSeparator}
+		p.sizes = l.CurrencyGroupSizes
+		if isSpace(l.CurrencyGroupSeparator) {
+			// Users type a plain space rather than a no-break space.
+			p.groups = append(p.groups, " ", "\u00a0", "\u202f")
+		}
+	}
+	var b strings.Builder
+	for i, r := range input {
+		if unicode.Is(unicode.Cf, r) {
+			continue
+		}
+		for j := 0; j < utf8.RuneLen(r); j++ {
+			p.offsets = append(p.offsets, i+j)
+		}
+		b.WriteRune(r)
+	}
+	p.offsets = append(p.offsets, len(input))
+	p.s = b.String()
+	return p
+}
+
+func (p *moneyParser) errorf(pos int, err error, format string, args ...interface{}) error {
+	return &ParseError{Input: p.input, Offset: p.offsets[pos], Reason: fmt.Sprintf(format, args...), Err: err}
+}
+
+func (p *moneyParser) parse(currency CurrencyCode) (Money, error) {
+	var (
+		negative, signSeen bool
+		parenOpen          = -1
+		parenClosed        bool
+		code               CurrencyCode
+		codePos            int
+		whole, frac        string
+		numberPos          = -1
+	)
+
+	s := p.s
+	for pos := 0; pos < len(s); {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		switch {
+		case unicode.IsSpace(r):
+			pos += size
+		case r == '(':
+			if parenOpen >= 0 || signSeen {
+				return Money{}, p.errorf(pos, nil, "unexpected %q", r)
+			}
+			parenOpen = pos
+			negative = true
+			pos += size
+		case r == ')':
+			if parenOpen < 0 || parenClosed {
+				return Money{}, p.errorf(pos, nil, "unbalanced parenthesis")
+			}
+			parenClosed = true
+			pos += size
+		case p.hasSign(s[pos:], true) > 0, p.hasSign(s[pos:], false) > 0:
+			if signSeen || parenOpen >= 0 {
+				return Money{}, p.errorf(pos, nil, "unexpected sign")
+			}
+			signSeen = true
+			if n := p.hasSign(s[pos:], true); n > 0 {
+				negative = true
+				pos += n
+			} else {
+				pos += p.hasSign(s[pos:], false)
+			}
+		case r >= '0' && r <= '9' || strings.HasPrefix(s[pos:], p.decimal):
+			if numberPos >= 0 {
+				return Money{}, p.errorf(pos, nil, "unexpected second number")
+			}
+			numberPos = pos
+			var err error
+			whole, frac, pos, err = p.parseNumber(pos)
+			if err != nil {
+				return Money{}, err
+			}
+		default:
+			if code != "" {
+				return Money{}, p.errorf(pos, nil, "unexpected %q", r)
+			}
+			c, n, err := p.parseCurrency(pos, currency)
+			if err != nil {
+				return Money{}, err
+			}
+			code, codePos = c, pos
+			pos += n
+		}
+	}
+
+	if parenOpen >= 0 && !parenClosed {
+		return Money{}, p.errorf(parenOpen, nil, "unbalanced parenthesis")
+	}
+	if numberPos < 0 {
+		return Money{}, p.errorf(len(s), nil, "missing number")
+	}
+	switch {
+	case code == "" && currency != "":
+		code = currency
+	case code == "" && p.locale != nil:
+		code = p.locale.CurrencyCode
+	case code == "":
+		return Money{}, p.errorf(len(s), nil, "missing currency")
+	case currency != "" && code != currency:
+		return Money{}, p.errorf(codePos, ErrMoneyCurrencyMismatch, "expected currency %s, got %s", currency, code)
+	}
+
+	m := Money{C: code}
+	digits := m.digits()
+	if len(frac) > digits {
+		return Money{}, p.errorf(numberPos, nil, "%s allows at most %d decimals, got %d", code, digits, len(frac))
+	}
+	digitsStr := whole + frac + strings.Repeat("0", digits-len(frac))
+	if negative {
+		digitsStr = "-" + digitsStr
+	}
+	v, err := strconv.ParseInt(digitsStr, 10, 64)
+	if err != nil {
+		return Money{}, p.errorf(numberPos, ErrMoneyOverflow, "amount out of range")
+	}
+	m.M = v
+	return m, nil
+}
+
+// hasSign returns the length of the negative (or positive) sign at the
+// start of s, or 0 if s doesn't start with a sign.
+func (p *moneyParser) hasSign(s string, negative bool) int {
+	signs := []string{"+"}
+	if negative {
+		signs = []string{"-", "\u2212"}
+	}
+	if p.locale != nil {
+		if negative && p.locale.NegativeSign != "" {
+			signs = append(signs, p.locale.NegativeSign)
+		} else if !negative && p.locale.PositiveSign != "" {
+			signs = append(signs, p.locale.PositiveSign)
+		}
+	}
+	for _, sign := range signs {
+		if strings.HasPrefix(s, sign) {
+			return len(sign)
+		}
+	}
+	return 0
+}
+
+// parseNumber parses the number starting at pos and returns its whole and
+// fractional digits, and the position after the number.
+func (p *moneyParser) parseNumber(pos int) (whole, frac string, end int, err error) {
+	s := p.s
+	var (
+		inFrac bool
+		// groups are the lengths of the digit groups of the whole part, and
+		// seps the positions of the separators between them.
+		groups = []int{0}
+		seps   []int
+	)
+	for pos < len(s) {
+		c := s[pos]
+		switch {
+		case c >= '0' && c <= '9':
+			if inFrac {
+				frac += string(c)
+			} else {
+				whole += string(c)
+				groups[len(groups)-1]++
+			}
+			pos++
+			continue
+		case strings.HasPrefix(s[pos:], p.decimal):
+			if inFrac {
+				return "", "", pos, p.errorf(pos, nil, "unexpected second decimal separator")
+			}
+			inFrac = true
+			pos += len(p.decimal)
+			continue
+		}
+		if sep := p.groupSeparator(s[pos:]); sep != "" && !inFrac && whole != "" && startsWithDigit(s[pos+len(sep):]) {
+			groups = append(groups, 0)
+			seps = append(seps, pos)
+			pos += len(sep)
+			continue
+		}
+		break
+	}
+	if len(seps) > 0 {
+		if sep, ok := p.checkGroups(whole, groups, seps); !ok {
+			return "", "", pos, p.errorf(sep, nil, "invalid digit grouping")
+		}
+	}
+	if whole == "" && frac == "" {
+		return "", "", pos, p.errorf(pos, nil, "missing digits")
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	return whole, frac, pos, nil
+}
+
+// checkGroups checks that the digit groups of whole have the sizes that
+// the locale groups them in, with the same rules as groupDigits, so that
+// e.g. "12,50" isn't taken for 1250. Otherwise it returns the position of
+// the separator in front of the first group, from the right, that doesn't
+// match.
+func (p *moneyParser) checkGroups(whole string, groups, seps []int) (int, bool) {
+	expected := strings.Split(groupDigits(whole, p.sizes, ","), ",")
+	for k := 0; k < len(groups); k++ {
+		i, j := len(groups)-1-k, len(expected)-1-k
+		if j < 0 || groups[i] != len(expected[j]) {
+			if i == 0 {
+				return seps[0], false
+			}
+			return seps[i-1], false
+		}
+	}
+	return 0, len(groups) == len(expected)
+}
+
+// groupSeparator returns the group separator s starts with, if any.
+func (p *moneyParser) groupSeparator(s string) string {
+	for _, sep := range p.groups {
+		if sep != "" && strings.HasPrefix(s, sep) {
+			return sep
+		}
+	}
+	return ""
+}
+
+// parseCurrency parses the ISO code or currency symbol at pos and returns
+// the currency and the length of the text it consumed.
+func (p *moneyParser) parseCurrency(pos int, preferred CurrencyCode) (CurrencyCode, int, error) {
+	s := p.s[pos:]
+
+	// ISO codes, in any case, as long as they are not part of a longer word.
+	if len(s) >= 3 {
+		code := CurrencyCode(strings.ToUpper(s[:3]))
+		next, _ := utf8.DecodeRuneInString(s[3:])
+		if _, found := Currencies[code]; found && !unicode.IsLetter(next) {
+			return code, 3, nil
+		}
+	}
+
+	// Symbols, longest match first
+	var (
+		candidates []CurrencyCode
+		length     int
+	)
+	for _, m := range currencySymbols(p.locale) {
+		if !strings.HasPrefix(s, m.symbol) || len(m.symbol) < length {
+			continue
+		}
+		if len(m.symbol) > length {
+			candidates, length = nil, len(m.symbol)
+		}
+		candidates = append(candidates, m.code)
+	}
+	if len(candidates) == 0 {
+		r, _ := utf8.DecodeRuneInString(s)
+		return "", 0, p.errorf(pos, nil, "unexpected %q", r)
+	}
+	var localeCode CurrencyCode
+	if p.locale != nil {
+		localeCode = p.locale.CurrencyCode
+	}
+	for _, prefer := range []CurrencyCode{preferred, localeCode} {
+		for _, c := range candidates {
+			if prefer != "" && c == prefer {
+				return c, length, nil
+			}
+		}
+	}
+	if len(candidates) > 1 {
+		if c, found := commonSymbols[s[:length]]; found {
+			return c, length, nil
+		}
+		return "", 0, p.errorf(pos, nil, "ambiguous currency symbol %q: one of %v", s[:length], candidates)
+	}
+	return candidates[0], length, nil
+}
+
+// commonSymbols resolves symbols shared by several currencies, if neither
+// the caller nor the locale prefers one of them, to the currency most
+// commonly meant by it.
+var commonSymbols = map[string]CurrencyCode{
+	"$":    USD,
+	"£":    GBP,
+	"¥":    JPY,
+	"Din.": RSD,
+	"р.":   RUB,
+}
+
+type currencySymbol struct {
+	symbol string
+	code   CurrencyCode
+}
+
+// currencySymbols returns the symbols of all currencies, including the
+// symbol that the given locale (which may be nil) uses for its currency.
+func currencySymbols(l *Locale) []currencySymbol {
+	var symbols []currencySymbol
+	for code, c := range Currencies {
+		if sym := stripFormatting(c.Symbol); sym != "" {
+			symbols = append(symbols, currencySymbol{symbol: sym, code: code})
+		}
+	}
+	if l != nil {
+		if sym := stripFormatting(l.CurrencySymbol); sym != "" {
+			symbols = append(symbols, currencySymbol{symbol: sym, code: l.CurrencyCode})
+		}
+	}
+	// Sort for deterministic results
+	sort.Slice(symbols, func(i, j int) bool {
+		if symbols[i].code != symbols[j].code {
+			return symbols[i].code < symbols[j].code
+		}
+		return symbols[i].symbol < symbols[j].symbol
+	})
+	return symbols
+}
+
+// stripFormatting removes formatting characters, such as the
+// right-to-left mark, from s.
+func stripFormatting(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Cf, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func isSpace(s string) bool {
+	r, size := utf8.DecodeRuneInString(s)
+	return size == len(s) && unicode.IsSpace(r)
+}
+
+func startsWithDigit(s string) bool {
+	return len(s) > 0 && s[0] >= '0' && s[0] <= '9'
+}
+
+// parseMoneyString parses the canonical text form of Money.String, e.g.
+// "-1234.56 USD". Fewer decimals than the currency has are accepted.
+func parseMoneyString(s string) (Money, error) {
+	v, err := parseBigMoneyString(s)
+	if err != nil {
+		return Money{}, err
+	}
+	m, err := v.Money()
+	if err != nil {
+		return Money{}, &ParseError{Input: s, Offset: 0, Reason: "amount out of range", Err: err}
+	}
+	return m, nil
+}
+
+// parseBigMoneyString is like parseMoneyString, but returns a BigMoney.
+func parseBigMoneyString(s string) (BigMoney, error) {
+	number, c, err := splitMoneyString(s)
+	if err != nil {
+		return BigMoney{}, err
+	}
+	v, err := parseBigDecimal(s, c, number)
+	if err != nil {
+		return BigMoney{}, err
+	}
+	return BigMoney{M: v, C: c}, nil
+}
+
+// splitMoneyString splits the canonical text form of Money.String into
+// the number and the currency, which must be in Currencies.
+func splitMoneyString(s string) (string, CurrencyCode, error) {
+	i := strings.LastIndexByte(s, ' ')
+	if i < 0 {
+		return "", "", &ParseError{Input: s, Offset: len(s), Reason: "missing currency"}
+	}
+	c := CurrencyCode(s[i+1:])
+	if _, found := Currencies[c]; !found {
+		return "", "", &ParseError{Input: s, Offset: i + 1, Reason: fmt.Sprintf("unknown currency %q", c), Err: ErrUnknownCurrency}
+	}
+	return s[:i], c, nil
+}
+
+// parseDecimal parses the decimal number, e.g. "-1234.56", into a Money
+// of currency c. Fewer decimals than the currency has are accepted.
+// Errors refer to input, which contains number at its start.
+func parseDecimal(input string, c CurrencyCode, number string) (Money, error) {
+	v, err := parseBigDecimal(input, c, number)
+	if err != nil {
+		return Money{}, err
+	}
+	if !v.IsInt64() {
+		return Money{}, &ParseError{Input: input, Offset: 0, Reason: "amount out of range", Err: ErrMoneyOverflow}
+	}
+	return Money{M: v.Int64(), C: c}, nil
+}
+
+// parseBigDecimal is like parseDecimal, but returns the minor units of
+// the amount as a *big.Int.
+func parseBigDecimal(input string, c CurrencyCode, number string) (*big.Int, error) {
+	sign, whole, frac, err := splitDecimal(input, number)
+	if err != nil {
+		return nil, err
+	}
+	digits := Money{C: c}.digits()
+	if len(frac) > digits {
+		return nil, &ParseError{Input: input, Offset: 0, Reason: fmt.Sprintf("%s allows at most %d decimals, got %d", c, digits, len(frac))}
+	}
+	v, _ := new(big.Int).SetString(sign+whole+frac+strings.Repeat("0", digits-len(frac)), 10)
+	return v, nil
+}
+
+// splitDecimal splits the decimal number, e.g. "-1234.56", into its sign,
+// whole and fractional digits. Errors refer to input, which contains
+// number at its start.
+func splitDecimal(input, number string) (sign, whole, frac string, err error) {
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+	whole = number
+	if j := strings.IndexByte(number, '.'); j >= 0 {
+		whole, frac = number[:j], number[j+1:]
+		if frac == "" {
+			return "", "", "", &ParseError{Input: input, Offset: len(sign) + j, Reason: "missing decimals"}
+		}
+	}
+	if !isDigits(whole) || frac != "" && !isDigits(frac) {
+		return "", "", "", &ParseError{Input: input, Offset: 0, Reason: fmt.Sprintf("invalid number %q", sign+number), Err: ErrMoneyInvalidNumber}
+	}
+	return sign, whole, frac, nil
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
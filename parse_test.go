@@ -0,0 +1,124 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		locale   string
+		input    string
+		expected Money
+	}{
+		{"de_DE", "1.234,56 €", Money{123456, "EUR"}},
+		{"de_DE", "-1.234,56 €", Money{-123456, "EUR"}},
+		{"de_DE", "1234,5", Money{123450, "EUR"}},
+		{"de_DE", "1.234 EUR", Money{123400, "EUR"}},
+		{"de_DE", "12 usd", Money{1200, "USD"}},
+		{"de_DE", "1.234.567,89 £", Money{123456789, "GBP"}},
+		{"de_DE", "1,50 $", Money{150, "USD"}},
+		{"zh_CN", "¥1.50", Money{150, "CNY"}},
+		{"en_US", "¥150", Money{150, "JPY"}},
+		{"en_US", "-$1,234.56", Money{-123456, "USD"}},
+		{"en_US", "($1,234.56)", Money{-123456, "USD"}},
+		{"en_US", "$ 1234.56", Money{123456, "USD"}},
+		{"en_US", "+1,234.56 USD", Money{123456, "USD"}},
+		{"en_US", "€0.01", Money{1, "EUR"}},
+		{"en_US", ".5", Money{50, "USD"}},
+		{"de_CH", "fr. 1'234.56", Money{123456, "CHF"}},
+		{"de_CH", "€-1'234.56", Money{-123456, "EUR"}},
+		{"hu_HU", "12 345 678,90 Ft", Money{1234567890, "HUF"}},
+		{"ja_JP", "¥1,234,567,890", Money{1234567890, "JPY"}},
+		{"ja_JP", "-¥1,234", Money{-1234, "JPY"}},
+		{"en_US", "1,234.567 KWD", Money{1234567, "KWD"}},
+		{"da_DK", "kr. 1.234,56", Money{123456, "DKK"}},
+		{"hi_IN", "12,34,567.89 INR", Money{123456789, "INR"}},
+		{"xx_XX", "-1234.56 EUR", Money{-123456, "EUR"}},
+		{"", "1234 JPY", Money{1234, "JPY"}},
+	}
+	for _, test := range tests {
+		got, err := ParseMoney(test.locale, test.input)
+		if err != nil {
+			t.Errorf("%s: %q: unexpected error %v", test.locale, test.input, err)
+			continue
+		}
+		if got != test.expected {
+			t.Errorf("%s: %q: expected %v, got %v", test.locale, test.input, test.expected, got)
+		}
+	}
+}
+
+func TestParseMoneyErrors(t *testing.T) {
+	tests := []struct {
+		locale string
+		input  string
+		offset int
+		err    error
+	}{
+		{"de_DE", "", 0, nil},
+		{"de_DE", "€", 3, nil},
+		{"de_DE", "1,234 €", 0, nil},
+		{"de_DE", "1,2,3 €", 3, nil},
+		{"de_DE", "1 € 2", 6, nil},
+		{"de_DE", "12 € $", 7, nil},
+		{"de_DE", "12 #", 3, nil},
+		{"de_DE", "--12", 1, nil},
+		{"en_US", "($12", 0, nil},
+		{"en_US", "$12)", 3, nil},
+		{"en_US", "99999999999999999999", 0, ErrMoneyOverflow},
+		{"en_US", "12,50", 2, nil},
+		{"en_US", "1,234,56", 5, nil},
+		{"en_US", "1234,567", 4, nil},
+		{"en_US", "$1,23.45", 2, nil},
+		{"de_DE", "1.5 €", 1, nil},
+		{"hi_IN", "1,234,567 INR", 1, nil},
+		{"fr_FR", "12 kr", 3, nil},
+		{"xx_XX", "12", 2, nil},
+	}
+	for _, test := range tests {
+		_, err := ParseMoney(test.locale, test.input)
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%s: %q: expected a *ParseError, got %v", test.locale, test.input, err)
+			continue
+		}
+		if perr.Offset != test.offset {
+			t.Errorf("%s: %q: expected offset %d, got %d (%v)", test.locale, test.input, test.offset, perr.Offset, err)
+		}
+		if test.err != nil && !errors.Is(err, test.err) {
+			t.Errorf("%s: %q: expected %v, got %v", test.locale, test.input, test.err, err)
+		}
+	}
+}
+
+func TestParseMoneyIn(t *testing.T) {
+	got, err := ParseMoneyIn("fr_FR", USD, "12,50 $")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (Money{1250, "USD"}); got != expected {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if _, err := ParseMoneyIn("fr_FR", USD, "12,50 €"); !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected %v, got %v", ErrMoneyCurrencyMismatch, err)
+	}
+}
+
+func TestParseMoneyRoundTrip(t *testing.T) {
+	amounts := []int64{0, 1, -1, 12, 123456, -123456, 1234567890, -1234567890}
+	for code, l := range Locales {
+		for _, amount := range amounts {
+			m := Money{amount, l.CurrencyCode}
+			s := m.Format(code)
+			got, err := ParseMoney(code, s)
+			if err != nil {
+				t.Errorf("%s: %q: unexpected error %v", code, s, err)
+				continue
+			}
+			if got != m {
+				t.Errorf("%s: %q: expected %v, got %v", code, s, m, got)
+			}
+		}
+	}
+}
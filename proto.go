@@ -0,0 +1,95 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	// ErrMoneyInexact is returned when an amount cannot be represented
+	// exactly in the minor units of its currency.
+	ErrMoneyInexact = errors.New("i18n: money cannot be represented exactly")
+	// ErrInvalidProtoMoney is returned for a ProtoMoney that violates the
+	// constraints of google.type.Money.
+	ErrInvalidProtoMoney = errors.New("i18n: invalid proto money")
+)
+
+// nanosPerUnit is the number of nanos in a unit of a ProtoMoney.
+const nanosPerUnit = 1e9
+
+// ProtoMoney has the shape of google.type.Money, so that it can be copied
+// field by field to and from the generated protobuf type:
+//
+//	pb := &money.Money{CurrencyCode: p.CurrencyCode, Units: p.Units, Nanos: p.Nanos}
+type ProtoMoney struct {
+	// CurrencyCode is the ISO 4217 code of the currency.
+	CurrencyCode string
+	// Units is the whole units of the amount, e.g. 1 for 1.75 USD.
+	Units int64
+	// Nanos is the number of nano (10^-9) units of the amount, between
+	// -999,999,999 and 999,999,999, e.g. 750,000,000 for 1.75 USD.
+	// Its sign must match the sign of Units, if Units is not zero.
+	Nanos int32
+}
+
+// ToProto converts m to a ProtoMoney. It returns ErrUnknownCurrency if the
+// currency of m isn't in Currencies.
+func (m Money) ToProto() (ProtoMoney, error) {
+	if err := m.C.validate(); err != nil {
+		return ProtoMoney{}, err
+	}
+	dp := m.dp()
+	return ProtoMoney{
+		CurrencyCode: string(m.C),
+		Units:        m.M / dp,
+		Nanos:        int32(m.M % dp * (nanosPerUnit / dp)),
+	}, nil
+}
+
+// MoneyFromProto converts p to a Money. It returns ErrMoneyInexact if the
+// nanos of p are finer than the minor unit of the currency, e.g. 1.755 USD,
+// ErrMoneyOverflow if the amount doesn't fit into a Money, and
+// ErrUnknownCurrency or ErrInvalidProtoMoney if p is invalid.
+func MoneyFromProto(p ProtoMoney) (Money, error) {
+	m, exact, err := moneyFromProto(p, RoundTruncate)
+	if err != nil {
+		return Money{}, err
+	}
+	if !exact {
+		return Money{}, fmt.Errorf("%w: %d nanos of %s", ErrMoneyInexact, p.Nanos, p.CurrencyCode)
+	}
+	return m, nil
+}
+
+// MoneyFromProtoRounded is like MoneyFromProto, but rounds nanos that are
+// finer than the minor unit of the currency with the given mode.
+func MoneyFromProtoRounded(p ProtoMoney, mode RoundingMode) (Money, error) {
+	m, _, err := moneyFromProto(p, mode)
+	return m, err
+}
+
+func moneyFromProto(p ProtoMoney, mode RoundingMode) (m Money, exact bool, err error) {
+	c := CurrencyCode(p.CurrencyCode)
+	if err := c.validate(); err != nil {
+		return Money{}, false, err
+	}
+	if p.Nanos <= -nanosPerUnit || p.Nanos >= nanosPerUnit {
+		return Money{}, false, fmt.Errorf("%w: nanos %d out of range", ErrInvalidProtoMoney, p.Nanos)
+	}
+	if p.Units > 0 && p.Nanos < 0 || p.Units < 0 && p.Nanos > 0 {
+		return Money{}, false, fmt.Errorf("%w: units %d and nanos %d have different signs", ErrInvalidProtoMoney, p.Units, p.Nanos)
+	}
+
+	m = Money{C: c}
+	dp := m.dp()
+	step := int64(nanosPerUnit) / dp
+	minor := mode.round(big.NewInt(int64(p.Nanos)), big.NewInt(step))
+	v := new(big.Int).Mul(big.NewInt(p.Units), big.NewInt(dp))
+	v.Add(v, minor)
+	if !v.IsInt64() {
+		return Money{}, false, ErrMoneyOverflow
+	}
+	m.M = v.Int64()
+	return m, int64(p.Nanos)%step == 0, nil
+}
@@ -0,0 +1,76 @@
+package i18n
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMoneyToProto(t *testing.T) {
+	tests := []struct {
+		m        Money
+		expected ProtoMoney
+	}{
+		{Money{M: 175, C: USD}, ProtoMoney{"USD", 1, 750000000}},
+		{Money{M: -175, C: USD}, ProtoMoney{"USD", -1, -750000000}},
+		{Money{M: -5, C: USD}, ProtoMoney{"USD", 0, -50000000}},
+		{Money{M: 1234, C: JPY}, ProtoMoney{"JPY", 1234, 0}},
+		{Money{M: 1001, C: KWD}, ProtoMoney{"KWD", 1, 1000000}},
+		{Money{M: math.MinInt64, C: EUR}, ProtoMoney{"EUR", -92233720368547758, -80000000}},
+	}
+	for _, test := range tests {
+		got, err := test.m.ToProto()
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", test.m, err)
+		}
+		if got != test.expected {
+			t.Errorf("%v: expected %+v, got %+v", test.m, test.expected, got)
+		}
+		m, err := MoneyFromProto(got)
+		if err != nil || m != test.m {
+			t.Errorf("%+v: expected %v, got %v, %v", got, test.m, m, err)
+		}
+	}
+	if _, err := (Money{M: 1}).ToProto(); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
+
+func TestMoneyFromProto(t *testing.T) {
+	tests := []struct {
+		p        ProtoMoney
+		mode     RoundingMode
+		expected Money
+		err      error
+	}{
+		{ProtoMoney{"USD", 1, 755000000}, RoundHalfEven, Money{M: 176, C: USD}, ErrMoneyInexact},
+		{ProtoMoney{"USD", -1, -755000000}, RoundHalfEven, Money{M: -176, C: USD}, ErrMoneyInexact},
+		{ProtoMoney{"USD", 1, 745000000}, RoundHalfEven, Money{M: 174, C: USD}, ErrMoneyInexact},
+		{ProtoMoney{"USD", 1, 1}, RoundUp, Money{M: 101, C: USD}, ErrMoneyInexact},
+		{ProtoMoney{"JPY", 5, 500000000}, RoundHalfUp, Money{M: 6, C: JPY}, ErrMoneyInexact},
+		{ProtoMoney{"USD", 0, 1000000000}, RoundHalfUp, Money{}, ErrInvalidProtoMoney},
+		{ProtoMoney{"USD", 1, -1}, RoundHalfUp, Money{}, ErrInvalidProtoMoney},
+		{ProtoMoney{"USD", -1, 1}, RoundHalfUp, Money{}, ErrInvalidProtoMoney},
+		{ProtoMoney{"XXY", 1, 0}, RoundHalfUp, Money{}, ErrUnknownCurrency},
+		{ProtoMoney{"USD", math.MaxInt64, 0}, RoundHalfUp, Money{}, ErrMoneyOverflow},
+		{ProtoMoney{"USD", 92233720368547758, 80000000}, RoundHalfUp, Money{}, ErrMoneyOverflow},
+		{ProtoMoney{"USD", 92233720368547758, 69000000}, RoundHalfUp, Money{M: math.MaxInt64, C: USD}, ErrMoneyInexact},
+	}
+	for _, test := range tests {
+		m, err := MoneyFromProto(test.p)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%+v: expected %v, got %v", test.p, test.err, err)
+		}
+		if m != (Money{}) {
+			t.Errorf("%+v: expected zero Money on error, got %v", test.p, m)
+		}
+		m, err = MoneyFromProtoRounded(test.p, test.mode)
+		if test.err == ErrMoneyInexact {
+			if err != nil || m != test.expected {
+				t.Errorf("%+v rounded: expected %v, got %v, %v", test.p, test.expected, m, err)
+			}
+		} else if !errors.Is(err, test.err) {
+			t.Errorf("%+v rounded: expected %v, got %v", test.p, test.err, err)
+		}
+	}
+}
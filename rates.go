@@ -0,0 +1,209 @@
+package i18n
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrRateNotFound is returned when no exchange rate is known between two
+// currencies.
+var ErrRateNotFound = errors.New("i18n: exchange rate not found")
+
+// ExchangeRate is the rate between two currencies at a point in time.
+type ExchangeRate struct {
+	From CurrencyCode
+	To   CurrencyCode
+	// Rate is the factor to multiply an amount in From with to get the
+	// equivalent amount in To.
+	Rate *big.Rat
+	// Time is when the rate was published or last updated.
+	Time time.Time
+}
+
+// RateProvider is a RateSource that also provides the time the rates were
+// published. Errors for unknown pairs match ErrRateNotFound.
+//
+// Like any RateSource, a RateProvider can be used with Money.Convert,
+// MoneyMath.Rates and MoneyBag.Collapse.
+type RateProvider interface {
+	RateSource
+	// ExchangeRate returns the rate from currency from to currency to,
+	// with the time it was published.
+	ExchangeRate(from, to CurrencyCode) (ExchangeRate, error)
+}
+
+// Convert returns m converted into currency to with a rate from rates,
+// rounded to the minor unit of to with RoundHalfCeiling. It returns
+// ErrMoneyNoRateSource if rates is nil.
+func (m Money) Convert(to CurrencyCode, rates RateSource) (Money, error) {
+	return m.ConvertRounded(to, rates, RoundHalfCeiling)
+}
+
+// ConvertRounded is like Convert, but rounds with the given mode.
+func (m Money) ConvertRounded(to CurrencyCode, rates RateSource, mode RoundingMode) (Money, error) {
+	if m.C == to {
+		return m, nil
+	}
+	if rates == nil {
+		return Money{}, ErrMoneyNoRateSource
+	}
+	rate, err := rates.Rate(m.C, to)
+	if err != nil {
+		return Money{}, err
+	}
+	return m.convert(to, rate, mode)
+}
+
+// identityRate returns the rate between c and itself.
+func identityRate(c CurrencyCode) ExchangeRate {
+	return ExchangeRate{From: c, To: c, Rate: big.NewRat(1, 1)}
+}
+
+func rateNotFound(from, to CurrencyCode) error {
+	return fmt.Errorf("%w: %s to %s", ErrRateNotFound, from, to)
+}
+
+// StaticRates is an in-memory RateProvider of fixed rates. If only the rate
+// from one currency to another is set, the inverse rate is used for the
+// opposite direction. The zero value has no rates. It is safe for
+// concurrent use.
+type StaticRates struct {
+	mu    sync.RWMutex
+	rates map[[2]CurrencyCode]ExchangeRate
+}
+
+// Set sets the rate from currency from to currency to, published at t.
+func (s *StaticRates) Set(from, to CurrencyCode, rate *big.Rat, t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rates == nil {
+		s.rates = make(map[[2]CurrencyCode]ExchangeRate)
+	}
+	s.rates[[2]CurrencyCode{from, to}] = ExchangeRate{From: from, To: to, Rate: new(big.Rat).Set(rate), Time: t}
+}
+
+// ExchangeRate implements RateProvider.
+func (s *StaticRates) ExchangeRate(from, to CurrencyCode) (ExchangeRate, error) {
+	if from == to {
+		return identityRate(from), nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if r, found := s.rates[[2]CurrencyCode{from, to}]; found {
+		r.Rate = new(big.Rat).Set(r.Rate)
+		return r, nil
+	}
+	if r, found := s.rates[[2]CurrencyCode{to, from}]; found && r.Rate.Sign() != 0 {
+		return ExchangeRate{From: from, To: to, Rate: new(big.Rat).Inv(r.Rate), Time: r.Time}, nil
+	}
+	return ExchangeRate{}, rateNotFound(from, to)
+}
+
+// Rate implements RateSource.
+func (s *StaticRates) Rate(from, to CurrencyCode) (*big.Rat, error) {
+	r, err := s.ExchangeRate(from, to)
+	return r.Rate, err
+}
+
+// CrossRates is a RateProvider that triangulates through a base currency:
+// the rate from A to B is the rate from A to Base times the rate from Base
+// to B. The time of a cross rate is the older time of both rates.
+type CrossRates struct {
+	// Base is the currency that all rates go through, e.g. EUR.
+	Base CurrencyCode
+	// Provider provides the rates to and from Base.
+	Provider RateProvider
+}
+
+// ExchangeRate implements RateProvider.
+func (c *CrossRates) ExchangeRate(from, to CurrencyCode) (ExchangeRate, error) {
+	if from == to {
+		return identityRate(from), nil
+	}
+	if from == c.Base || to == c.Base {
+		return c.Provider.ExchangeRate(from, to)
+	}
+	a, err := c.Provider.ExchangeRate(from, c.Base)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	b, err := c.Provider.ExchangeRate(c.Base, to)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	t := a.Time
+	if b.Time.Before(t) {
+		t = b.Time
+	}
+	return ExchangeRate{From: from, To: to, Rate: new(big.Rat).Mul(a.Rate, b.Rate), Time: t}, nil
+}
+
+// Rate implements RateSource.
+func (c *CrossRates) Rate(from, to CurrencyCode) (*big.Rat, error) {
+	r, err := c.ExchangeRate(from, to)
+	return r.Rate, err
+}
+
+// ecbEnvelope is the format of the euro foreign exchange reference rates
+// of the European Central Bank, e.g. eurofxref-daily.xml.
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ParseECBRates reads the euro foreign exchange reference rates of the
+// European Central Bank in their XML format, e.g. the daily file
+// eurofxref-daily.xml or the historical file eurofxref-hist.xml. If the file
+// holds several days, the latest rate of each currency is used. The rates
+// are dated at midnight UTC of their day.
+//
+// The result converts between any two currencies of the file, and EUR.
+func ParseECBRates(r io.Reader) (*CrossRates, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("i18n: invalid ECB rates: %w", err)
+	}
+	rates := &StaticRates{}
+	for _, day := range envelope.Days {
+		t, err := time.Parse("2006-01-02", day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("i18n: invalid ECB rates: %w", err)
+		}
+		for _, rate := range day.Rates {
+			v, ok := new(big.Rat).SetString(rate.Rate)
+			if !ok || v.Sign() <= 0 {
+				return nil, fmt.Errorf("i18n: invalid ECB rate %q for %s", rate.Rate, rate.Currency)
+			}
+			c := CurrencyCode(rate.Currency)
+			if current, err := rates.ExchangeRate(EUR, c); err == nil && current.Time.After(t) {
+				continue
+			}
+			rates.Set(EUR, c, v, t)
+		}
+	}
+	if rates.rates == nil {
+		return nil, errors.New("i18n: invalid ECB rates: no rates found")
+	}
+	return &CrossRates{Base: EUR, Provider: rates}, nil
+}
+
+// LoadECBRates is like ParseECBRates, but reads the named file.
+func LoadECBRates(filename string) (*CrossRates, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseECBRates(f)
+}
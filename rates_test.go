@@ -0,0 +1,143 @@
+package i18n
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStaticRates(t *testing.T) {
+	published := time.Date(2024, 5, 17, 16, 0, 0, 0, time.UTC)
+	var rates StaticRates
+	rates.Set(EUR, USD, big.NewRat(10866, 10000), published)
+
+	r, err := rates.ExchangeRate(EUR, USD)
+	if err != nil || r.Rate.Cmp(big.NewRat(10866, 10000)) != 0 || !r.Time.Equal(published) || r.From != EUR || r.To != USD {
+		t.Errorf("unexpected rate %+v, %v", r, err)
+	}
+	r, err = rates.ExchangeRate(USD, EUR)
+	if err != nil || r.Rate.Cmp(big.NewRat(10000, 10866)) != 0 || !r.Time.Equal(published) || r.From != USD || r.To != EUR {
+		t.Errorf("unexpected inverse rate %+v, %v", r, err)
+	}
+	if r, err := rates.ExchangeRate(GBP, GBP); err != nil || r.Rate.Cmp(big.NewRat(1, 1)) != 0 {
+		t.Errorf("unexpected identity rate %+v, %v", r, err)
+	}
+	if _, err := rates.ExchangeRate(EUR, GBP); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("expected ErrRateNotFound, got %v", err)
+	}
+
+	// Rates are copied
+	r, _ = rates.ExchangeRate(EUR, USD)
+	r.Rate.SetInt64(2)
+	if r, _ := rates.Rate(EUR, USD); r.Cmp(big.NewRat(10866, 10000)) != 0 {
+		t.Errorf("expected rate to be unchanged, got %v", r)
+	}
+}
+
+func TestCrossRates(t *testing.T) {
+	older := time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC)
+	var rates StaticRates
+	rates.Set(EUR, USD, big.NewRat(11, 10), newer)
+	rates.Set(EUR, GBP, big.NewRat(85, 100), older)
+	cross := &CrossRates{Base: EUR, Provider: &rates}
+
+	r, err := cross.ExchangeRate(GBP, USD)
+	if err != nil || r.Rate.Cmp(big.NewRat(110, 85)) != 0 || !r.Time.Equal(older) || r.From != GBP || r.To != USD {
+		t.Errorf("unexpected cross rate %+v, %v", r, err)
+	}
+	if r, err := cross.ExchangeRate(USD, EUR); err != nil || r.Rate.Cmp(big.NewRat(10, 11)) != 0 {
+		t.Errorf("unexpected rate %+v, %v", r, err)
+	}
+	if _, err := cross.ExchangeRate(GBP, JPY); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("expected ErrRateNotFound, got %v", err)
+	}
+}
+
+func TestMoneyConvert(t *testing.T) {
+	rates, err := LoadECBRates("testdata/eurofxref-daily.xml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		m        Money
+		to       CurrencyCode
+		mode     RoundingMode
+		expected Money
+	}{
+		{Money{M: 10000, C: EUR}, USD, RoundHalfCeiling, Money{M: 10866, C: USD}},
+		{Money{M: 10000, C: EUR}, JPY, RoundHalfCeiling, Money{M: 16938, C: JPY}},
+		{Money{M: 100, C: USD}, JPY, RoundHalfCeiling, Money{M: 156, C: JPY}},
+		{Money{M: 100, C: USD}, JPY, RoundFloor, Money{M: 155, C: JPY}},
+		{Money{M: 10000, C: JPY}, USD, RoundHalfCeiling, Money{M: 6415, C: USD}},
+		{Money{M: -10000, C: GBP}, CHF, RoundHalfCeiling, Money{M: -11551, C: CHF}},
+		{Money{M: 1234, C: USD}, USD, RoundHalfCeiling, Money{M: 1234, C: USD}},
+	}
+	for _, test := range tests {
+		got, err := test.m.ConvertRounded(test.to, rates, test.mode)
+		if err != nil {
+			t.Errorf("%v to %s: unexpected error: %v", test.m, test.to, err)
+		}
+		if got != test.expected {
+			t.Errorf("%v to %s: expected %v, got %v", test.m, test.to, test.expected, got)
+		}
+	}
+	if got, err := (Money{M: 10000, C: EUR}).Convert(USD, rates); err != nil || got != (Money{M: 10866, C: USD}) {
+		t.Errorf("expected 108.66 USD, got %v, %v", got, err)
+	}
+	if _, err := (Money{M: 100, C: USD}).Convert(KWD, rates); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("expected ErrRateNotFound, got %v", err)
+	}
+
+	// The providers can be used as MoneyMath.Rates
+	math := MoneyMath{Policy: MismatchConvert, Rates: rates}
+	if got, err := math.Add(Money{M: 100, C: USD}, Money{M: 100, C: EUR}); err != nil || got != (Money{M: 209, C: USD}) {
+		t.Errorf("expected 2.09 USD, got %v, %v", got, err)
+	}
+
+	// Any RateSource can be used with Convert
+	source := testRates{EUR: {USD: big.NewRat(11, 10)}}
+	if got, err := (Money{M: 100, C: EUR}).Convert(USD, source); err != nil || got != (Money{M: 110, C: USD}) {
+		t.Errorf("expected 1.10 USD, got %v, %v", got, err)
+	}
+	if _, err := (Money{M: 100, C: EUR}).Convert(USD, nil); !errors.Is(err, ErrMoneyNoRateSource) {
+		t.Errorf("expected ErrMoneyNoRateSource, got %v", err)
+	}
+	if _, err := (BigMoney{M: big.NewInt(100), C: EUR}).Convert(USD, nil); !errors.Is(err, ErrMoneyNoRateSource) {
+		t.Errorf("expected ErrMoneyNoRateSource for BigMoney, got %v", err)
+	}
+	if got, err := (Money{M: 100, C: EUR}).Convert(EUR, nil); err != nil || got != (Money{M: 100, C: EUR}) {
+		t.Errorf("expected 1.00 EUR without a rate, got %v, %v", got, err)
+	}
+}
+
+func TestParseECBRates(t *testing.T) {
+	rates, err := LoadECBRates("testdata/eurofxref-hist.xml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r, err := rates.ExchangeRate(EUR, USD)
+	if err != nil || r.Rate.Cmp(big.NewRat(10866, 10000)) != 0 || !r.Time.Equal(time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected latest rate, got %+v, %v", r, err)
+	}
+	r, err = rates.ExchangeRate("CYP", EUR)
+	if err != nil || r.Rate.Cmp(big.NewRat(1000000, 585274)) != 0 || !r.Time.Equal(time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected CYP rate %+v, %v", r, err)
+	}
+
+	for _, data := range []string{
+		"",
+		"<Envelope><Cube></Cube></Envelope>",
+		"<Envelope><Cube><Cube time='yesterday'><Cube currency='USD' rate='1.1'/></Cube></Cube></Envelope>",
+		"<Envelope><Cube><Cube time='2024-05-17'><Cube currency='USD' rate='x'/></Cube></Cube></Envelope>",
+	} {
+		if _, err := ParseECBRates(strings.NewReader(data)); err == nil {
+			t.Errorf("%q: expected error", data)
+		}
+	}
+	if _, err := LoadECBRates("testdata/missing.xml"); err == nil {
+		t.Errorf("expected error")
+	}
+}
@@ -0,0 +1,107 @@
+package i18n
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// RoundingMode specifies how amounts are rounded to the minor unit of a
+// currency (or any other increment). The zero value is RoundHalfCeiling,
+// which is what the package has always used.
+type RoundingMode int
+
+const (
+	// RoundHalfCeiling rounds to the nearest neighbour, and half towards
+	// plus infinity: 2.5 becomes 3, -2.5 becomes -2.
+	RoundHalfCeiling RoundingMode = iota
+	// RoundHalfFloor rounds to the nearest neighbour, and half towards
+	// minus infinity: 2.5 becomes 2, -2.5 becomes -3.
+	RoundHalfFloor
+	// RoundHalfUp rounds to the nearest neighbour, and half away
+	// from zero: 2.5 becomes 3, -2.5 becomes -3.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest neighbour, and half towards
+	// zero: 2.5 becomes 2, -2.5 becomes -2.
+	RoundHalfDown
+	// RoundHalfEven rounds to the nearest neighbour, and half to the even
+	// neighbour: 2.5 becomes 2, 3.5 becomes 4.
+	RoundHalfEven
+	// RoundCeiling rounds towards plus infinity.
+	RoundCeiling
+	// RoundFloor rounds towards minus infinity.
+	RoundFloor
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundTruncate rounds towards zero, i.e. it drops the fraction.
+	RoundTruncate
+
+	// RoundBankers is the banker's rounding, an alias for RoundHalfEven.
+	RoundBankers = RoundHalfEven
+)
+
+var roundingModeNames = map[RoundingMode]string{
+	RoundHalfCeiling: "HalfCeiling",
+	RoundHalfFloor:   "HalfFloor",
+	RoundHalfUp:      "HalfUp",
+	RoundHalfDown:    "HalfDown",
+	RoundHalfEven:    "HalfEven",
+	RoundCeiling:     "Ceiling",
+	RoundFloor:       "Floor",
+	RoundUp:          "Up",
+	RoundTruncate:    "Truncate",
+}
+
+func (mode RoundingMode) String() string {
+	if name, found := roundingModeNames[mode]; found {
+		return name
+	}
+	return "RoundingMode(" + strconv.Itoa(int(mode)) + ")"
+}
+
+// roundRat rounds r to an integer that fits into an int64.
+func (mode RoundingMode) roundRat(r *big.Rat) (int64, error) {
+	v := mode.round(r.Num(), r.Denom())
+	if !v.IsInt64() {
+		return 0, ErrMoneyOverflow
+	}
+	return v.Int64(), nil
+}
+
+// round returns num/den rounded to an integer. den must be positive.
+func (mode RoundingMode) round(num, den *big.Int) *big.Int {
+	// big.Int.DivMod uses Euclidean division, i.e. q is num/den rounded
+	// towards minus infinity and 0 <= rem < den for positive divisors.
+	q, rem := new(big.Int).DivMod(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return q
+	}
+	// The exact value lies between q and q+1.
+	negative := q.Sign() < 0
+	half := new(big.Int).Lsh(rem, 1).Cmp(den)
+
+	var up bool
+	switch mode {
+	case RoundHalfFloor:
+		up = half > 0
+	case RoundHalfUp:
+		up = half > 0 || (half == 0 && !negative)
+	case RoundHalfDown:
+		up = half > 0 || (half == 0 && negative)
+	case RoundHalfEven:
+		up = half > 0 || (half == 0 && q.Bit(0) == 1)
+	case RoundCeiling:
+		up = true
+	case RoundFloor:
+		up = false
+	case RoundUp:
+		up = !negative
+	case RoundTruncate:
+		up = negative
+	default:
+		up = half >= 0
+	}
+	if up {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
@@ -0,0 +1,61 @@
+package i18n
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRoundingModes(t *testing.T) {
+	// Each row lists the expected results for:
+	// -2.6, -2.5, -2.4, -1.5, -0.5, 0.5, 1.5, 2.4, 2.5, 2.6
+	inputs := []*big.Rat{
+		big.NewRat(-26, 10), big.NewRat(-25, 10), big.NewRat(-24, 10),
+		big.NewRat(-15, 10), big.NewRat(-5, 10), big.NewRat(5, 10),
+		big.NewRat(15, 10), big.NewRat(24, 10), big.NewRat(25, 10),
+		big.NewRat(26, 10),
+	}
+	tests := []struct {
+		mode     RoundingMode
+		expected []int64
+	}{
+		{RoundHalfCeiling, []int64{-3, -2, -2, -1, 0, 1, 2, 2, 3, 3}},
+		{RoundHalfFloor, []int64{-3, -3, -2, -2, -1, 0, 1, 2, 2, 3}},
+		{RoundHalfUp, []int64{-3, -3, -2, -2, -1, 1, 2, 2, 3, 3}},
+		{RoundHalfDown, []int64{-3, -2, -2, -1, 0, 0, 1, 2, 2, 3}},
+		{RoundHalfEven, []int64{-3, -2, -2, -2, 0, 0, 2, 2, 2, 3}},
+		{RoundCeiling, []int64{-2, -2, -2, -1, 0, 1, 2, 3, 3, 3}},
+		{RoundFloor, []int64{-3, -3, -3, -2, -1, 0, 1, 2, 2, 2}},
+		{RoundUp, []int64{-3, -3, -3, -2, -1, 1, 2, 3, 3, 3}},
+		{RoundTruncate, []int64{-2, -2, -2, -1, 0, 0, 1, 2, 2, 2}},
+	}
+	for _, test := range tests {
+		for i, in := range inputs {
+			got, err := test.mode.roundRat(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.expected[i] {
+				t.Errorf("%v: expected %s to round to %d, got %d", test.mode, in.FloatString(1), test.expected[i], got)
+			}
+		}
+	}
+}
+
+func TestRoundingModeIntegers(t *testing.T) {
+	for mode := RoundHalfCeiling; mode <= RoundTruncate; mode++ {
+		got, err := mode.roundRat(big.NewRat(-7, 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != -7 {
+			t.Errorf("%v: expected -7, got %d", mode, got)
+		}
+	}
+}
+
+func TestRoundingModeOverflow(t *testing.T) {
+	r := new(big.Rat).SetFrac(new(big.Int).Lsh(big.NewInt(1), 70), big.NewInt(3))
+	if _, err := RoundHalfEven.roundRat(r); err != ErrMoneyOverflow {
+		t.Errorf("expected %v, got %v", ErrMoneyOverflow, err)
+	}
+}
@@ -0,0 +1,365 @@
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ErrMoneyInvalidScale is returned for a scale that is smaller than the
+// number of decimal places of the currency, or larger than MAXDEC.
+var ErrMoneyInvalidScale = errors.New("i18n: invalid money scale")
+
+// A ScaledMoney represents an amount of money with more decimal places
+// than the minor unit of its currency, e.g. a fuel price of 1.789 EUR per
+// litre, a price of 0.000025 USD per API call, or interest that accrues
+// daily. M holds the amount in units of 10^-Scale, e.g. 1789 with a Scale
+// of 3 for 1.789 EUR. Arithmetic is carried out at that scale, and
+// Quantize rounds the result back to the minor unit of the currency:
+//
+//	price, _ := ParseScaledMoney(EUR, "1.789")
+//	total, _ := price.MulDecimalString("45.27") // 80.988 EUR
+//	m, _ := total.Quantize(RoundHalfUp)         // 80.99 EUR
+//
+// A nil M is zero. Like Money, a ScaledMoney value is immutable: operations
+// return new values and never modify M.
+type ScaledMoney struct {
+	M     *big.Int
+	Scale int
+	C     CurrencyCode
+}
+
+// NewScaledMoney returns units of 10^-scale of currency c, e.g. 1789 and 3
+// for 1.789 EUR. It copies units. It returns ErrMoneyInvalidScale if scale
+// is smaller than the decimal places of c or larger than MAXDEC.
+func NewScaledMoney(c CurrencyCode, units *big.Int, scale int) (ScaledMoney, error) {
+	if err := checkScale(c, scale); err != nil {
+		return ScaledMoney{}, err
+	}
+	return ScaledMoney{M: new(big.Int).Set(units), Scale: scale, C: c}, nil
+}
+
+// ParseScaledMoney parses amount, a decimal number like "-0.000025", as an
+// amount of currency c. The scale is the number of decimals of amount, but
+// at least the decimal places of c. Errors are of type *ParseError.
+func ParseScaledMoney(c CurrencyCode, amount string) (ScaledMoney, error) {
+	if err := c.validate(); err != nil {
+		return ScaledMoney{}, &ParseError{Input: amount, Offset: 0, Reason: fmt.Sprintf("unknown currency %q", c), Err: err}
+	}
+	return parseScaledDecimal(amount, c, amount)
+}
+
+// parseScaledDecimal is like parseBigDecimal, but keeps all decimals of
+// number (see ParseScaledMoney).
+func parseScaledDecimal(input string, c CurrencyCode, number string) (ScaledMoney, error) {
+	sign, whole, frac, err := splitDecimal(input, number)
+	if err != nil {
+		return ScaledMoney{}, err
+	}
+	scale := Money{C: c}.digits()
+	if len(frac) > scale {
+		scale = len(frac)
+	}
+	if scale > MAXDEC {
+		return ScaledMoney{}, &ParseError{Input: input, Offset: 0, Reason: fmt.Sprintf("at most %d decimals allowed, got %d", MAXDEC, scale), Err: ErrMoneyInvalidScale}
+	}
+	v, _ := new(big.Int).SetString(sign+whole+frac+strings.Repeat("0", scale-len(frac)), 10)
+	return ScaledMoney{M: v, Scale: scale, C: c}, nil
+}
+
+// checkScale returns ErrMoneyInvalidScale if scale isn't allowed for
+// amounts of currency c.
+func checkScale(c CurrencyCode, scale int) error {
+	if digits := (Money{C: c}).digits(); scale < digits || scale > MAXDEC {
+		return fmt.Errorf("%w: %d, must be between %d and %d for %q", ErrMoneyInvalidScale, scale, digits, MAXDEC, c)
+	}
+	return nil
+}
+
+// WithScale converts m to a ScaledMoney with the given scale. It returns
+// ErrMoneyInvalidScale if scale is smaller than the decimal places of the
+// currency or larger than MAXDEC.
+func (m Money) WithScale(scale int) (ScaledMoney, error) {
+	return m.Big().WithScale(scale)
+}
+
+// WithScale is like Money.WithScale.
+func (m BigMoney) WithScale(scale int) (ScaledMoney, error) {
+	if err := checkScale(m.C, scale); err != nil {
+		return ScaledMoney{}, err
+	}
+	v := new(big.Int).Mul(m.int(), pow10Int(scale-Money{C: m.C}.digits()))
+	return ScaledMoney{M: v, Scale: scale, C: m.C}, nil
+}
+
+// Quantize returns m rounded to the minor unit of its currency with the
+// given mode. It returns ErrMoneyOverflow if the result doesn't fit into a
+// Money; see QuantizeBig for amounts of any size.
+func (m ScaledMoney) Quantize(mode RoundingMode) (Money, error) {
+	return m.QuantizeBig(mode).Money()
+}
+
+// QuantizeBig is like Quantize, but returns a BigMoney.
+func (m ScaledMoney) QuantizeBig(mode RoundingMode) BigMoney {
+	return BigMoney{M: m.round(Money{C: m.C}.digits(), mode), C: m.C}
+}
+
+// Rescale returns m with the given scale, rounded with mode if the scale
+// is smaller than the scale of m. It returns ErrMoneyInvalidScale if scale
+// is smaller than the decimal places of the currency or larger than MAXDEC.
+func (m ScaledMoney) Rescale(scale int, mode RoundingMode) (ScaledMoney, error) {
+	if err := checkScale(m.C, scale); err != nil {
+		return ScaledMoney{}, err
+	}
+	return ScaledMoney{M: m.round(scale, mode), Scale: scale, C: m.C}, nil
+}
+
+// round returns the units of m at the given scale, rounded with mode.
+func (m ScaledMoney) round(scale int, mode RoundingMode) *big.Int {
+	if scale >= m.Scale {
+		return new(big.Int).Mul(m.int(), pow10Int(scale-m.Scale))
+	}
+	return mode.round(m.int(), pow10Int(m.Scale-scale))
+}
+
+// int returns the units of m, which are never nil.
+func (m ScaledMoney) int() *big.Int {
+	if m.M == nil {
+		return new(big.Int)
+	}
+	return m.M
+}
+
+// Abs returns the absolute value of m.
+func (m ScaledMoney) Abs() ScaledMoney {
+	return ScaledMoney{M: new(big.Int).Abs(m.int()), Scale: m.Scale, C: m.C}
+}
+
+// Neg returns the negative value of m.
+func (m ScaledMoney) Neg() ScaledMoney {
+	return ScaledMoney{M: new(big.Int).Neg(m.int()), Scale: m.Scale, C: m.C}
+}
+
+// Sign returns the sign of m: -1 if negative, 0 if zero, and 1 if positive.
+func (m ScaledMoney) Sign() int {
+	return m.int().Sign()
+}
+
+// IsZero reports whether m is zero.
+func (m ScaledMoney) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Cmp compares the amounts of m and n regardless of their scales, and
+// returns -1, 0 or 1 like Money.Cmp. It returns a *CurrencyMismatchError
+// if the currencies differ; an empty currency is compatible with any
+// other currency.
+func (m ScaledMoney) Cmp(n ScaledMoney) (int, error) {
+	if m.C != "" && n.C != "" && m.C != n.C {
+		return 0, &CurrencyMismatchError{A: m.C, B: n.C}
+	}
+	scale := maxScale(m, n)
+	return m.round(scale, RoundTruncate).Cmp(n.round(scale, RoundTruncate)), nil
+}
+
+// Add returns the sum of m and n, at the larger scale of both.
+// It panics if the currencies of m and n differ.
+func (m ScaledMoney) Add(n ScaledMoney) ScaledMoney {
+	r, err := m.AddChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// AddChecked is like Add, but returns an error instead of panicking.
+// Operands of different currencies are handled like in Money.AddChecked.
+func (m ScaledMoney) AddChecked(n ScaledMoney) (ScaledMoney, error) {
+	return MoneyMath{}.AddScaled(m, n)
+}
+
+// Sub returns the result of subtracting n from m, at the larger scale of
+// both.
+// It panics if the currencies of m and n differ.
+func (m ScaledMoney) Sub(n ScaledMoney) ScaledMoney {
+	r, err := m.SubChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SubChecked is like Sub, but returns an error instead of panicking.
+// Operands of different currencies are handled like in Money.AddChecked.
+func (m ScaledMoney) SubChecked(n ScaledMoney) (ScaledMoney, error) {
+	return MoneyMath{}.SubScaled(m, n)
+}
+
+// AddScaled is like Add, but for ScaledMoney.
+func (a MoneyMath) AddScaled(m, n ScaledMoney) (ScaledMoney, error) {
+	m, n, err := a.scaledOperands(m, n)
+	if err != nil {
+		return ScaledMoney{}, err
+	}
+	return ScaledMoney{M: new(big.Int).Add(m.M, n.M), Scale: m.Scale, C: m.C}, nil
+}
+
+// SubScaled is like Sub, but for ScaledMoney.
+func (a MoneyMath) SubScaled(m, n ScaledMoney) (ScaledMoney, error) {
+	m, n, err := a.scaledOperands(m, n)
+	if err != nil {
+		return ScaledMoney{}, err
+	}
+	return ScaledMoney{M: new(big.Int).Sub(m.M, n.M), Scale: m.Scale, C: m.C}, nil
+}
+
+// scaledOperands returns m and n in the same currency, according to
+// a.Policy, and at the same scale.
+func (a MoneyMath) scaledOperands(m, n ScaledMoney) (ScaledMoney, ScaledMoney, error) {
+	rate, err := a.rate(m.C, n.C)
+	if err != nil {
+		return ScaledMoney{}, ScaledMoney{}, err
+	}
+	if rate != nil {
+		n = n.convert(m.C, rate)
+	}
+	if m.C == "" {
+		m.C = n.C
+	}
+	scale := maxScale(m, n)
+	m = ScaledMoney{M: m.round(scale, RoundTruncate), Scale: scale, C: m.C}
+	n = ScaledMoney{M: n.round(scale, RoundTruncate), Scale: scale, C: m.C}
+	return m, n, nil
+}
+
+// convert returns m converted into currency c with rate, at the scale of m
+// or the decimal places of c if they are more, rounded with RoundHalfCeiling.
+func (m ScaledMoney) convert(c CurrencyCode, rate *big.Rat) ScaledMoney {
+	scale := m.Scale
+	if digits := (Money{C: c}).digits(); digits > scale {
+		scale = digits
+	}
+	r := new(big.Rat).SetInt(m.round(scale, RoundTruncate))
+	r.Mul(r, rate)
+	return ScaledMoney{M: RoundHalfCeiling.round(r.Num(), r.Denom()), Scale: scale, C: c}
+}
+
+// maxScale returns the larger scale of m and n.
+func maxScale(m, n ScaledMoney) int {
+	if n.Scale > m.Scale {
+		return n.Scale
+	}
+	return m.Scale
+}
+
+// MulRat returns the result of multiplying m by r, e.g. a unit price by
+// a quantity, or a balance by a daily interest rate. The multiplication
+// is exact and only the result is rounded to the scale of m with
+// RoundHalfCeiling.
+func (m ScaledMoney) MulRat(r *big.Rat) ScaledMoney {
+	return m.MulRatRounded(r, RoundHalfCeiling)
+}
+
+// MulRatRounded is like MulRat, but rounds the result with the given mode.
+func (m ScaledMoney) MulRatRounded(r *big.Rat, mode RoundingMode) ScaledMoney {
+	res := new(big.Rat).SetInt(m.int())
+	res.Mul(res, r)
+	return ScaledMoney{M: mode.round(res.Num(), res.Denom()), Scale: m.Scale, C: m.C}
+}
+
+// MulDecimalString is like MulRat, but takes the decimal number in s,
+// e.g. "45.27" for a quantity of litres.
+func (m ScaledMoney) MulDecimalString(s string) (ScaledMoney, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return ScaledMoney{}, fmt.Errorf("%w: %q", ErrMoneyInvalidNumber, s)
+	}
+	return m.MulRat(r), nil
+}
+
+// String returns the amount of m with all decimals of its scale, followed
+// by the currency, e.g. "1.789 EUR".
+func (m ScaledMoney) String() string {
+	return decimalString(m.int(), m.Scale) + " " + string(m.C)
+}
+
+// Format formats m for the given locale like Money.Format, but with all
+// decimals of its scale, e.g. 1,789 € for de_DE.
+func (m ScaledMoney) Format(locale string) string {
+	return m.FormatWith(locale, FormatOptions{})
+}
+
+// FormatWith formats m for the given locale and options like
+// Money.FormatWith, but with all decimals of its scale.
+func (m ScaledMoney) FormatWith(locale string, opts FormatOptions) string {
+	l, found := Locales[locale]
+	if !found {
+		return m.String()
+	}
+	return formatMoney(l, m.C, m.int(), m.Scale, opts)
+}
+
+// MarshalText implements encoding.TextMarshaler. The text form is the one
+// of String, e.g. "1.789 EUR", or an empty text for the zero value.
+func (m ScaledMoney) MarshalText() ([]byte, error) {
+	if m.C == "" && m.IsZero() {
+		return nil, nil
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the form
+// of MarshalText, and keeps the decimals of the amount like
+// ParseScaledMoney. Errors are of type *ParseError.
+func (m *ScaledMoney) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*m = ScaledMoney{}
+		return nil
+	}
+	number, c, err := splitMoneyString(string(b))
+	if err != nil {
+		return err
+	}
+	v, err := parseScaledDecimal(string(b), c, number)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. m is always encoded like
+// JSONDecimal with all decimals of its scale, e.g.
+// {"amount":"1.789","currency":"EUR"}.
+func (m ScaledMoney) MarshalJSON() ([]byte, error) {
+	amount := decimalString(m.int(), m.Scale)
+	return json.Marshal(moneyMarshalContainer{Amount: &amount, Currency: &m.C})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the form of
+// MarshalJSON, and keeps the decimals of the amount like ParseScaledMoney.
+// It leaves m unchanged for null.
+func (m *ScaledMoney) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	var container moneyMarshalContainer
+	if err := json.Unmarshal(b, &container); err != nil {
+		return err
+	}
+	if container.Amount == nil || container.Currency == nil {
+		return fmt.Errorf("%w: missing amount or currency", ErrMoneyInvalidJSON)
+	}
+	c := *container.Currency
+	if err := c.validate(); err != nil {
+		return err
+	}
+	v, err := parseScaledDecimal(*container.Amount, c, *container.Amount)
+	if err != nil {
+		return fmt.Errorf("%w: amount: %v", ErrMoneyInvalidJSON, err)
+	}
+	*m = v
+	return nil
+}
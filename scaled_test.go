@@ -0,0 +1,212 @@
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func scaledMoney(t *testing.T, c CurrencyCode, amount string) ScaledMoney {
+	t.Helper()
+	m, err := ParseScaledMoney(c, amount)
+	if err != nil {
+		t.Fatalf("%s %s: %v", amount, c, err)
+	}
+	return m
+}
+
+func TestParseScaledMoney(t *testing.T) {
+	tests := []struct {
+		c        CurrencyCode
+		amount   string
+		expected string
+		scale    int
+	}{
+		{EUR, "1.789", "1.789 EUR", 3},
+		{USD, "-0.000025", "-0.000025 USD", 6},
+		{USD, "12", "12.00 USD", 2},
+		{JPY, "5", "5 JPY", 0},
+		{JPY, "0.5", "0.5 JPY", 1},
+	}
+	for _, test := range tests {
+		m := scaledMoney(t, test.c, test.amount)
+		if got := m.String(); got != test.expected || m.Scale != test.scale {
+			t.Errorf("%s %s: expected %s at scale %d, got %s at scale %d", test.amount, test.c, test.expected, test.scale, got, m.Scale)
+		}
+	}
+
+	if _, err := ParseScaledMoney(USD, "0.0000000000000000001"); !errors.Is(err, ErrMoneyInvalidScale) {
+		t.Errorf("expected ErrMoneyInvalidScale, got %v", err)
+	}
+	var perr *ParseError
+	if _, err := ParseScaledMoney(USD, "1.2.3"); !errors.As(err, &perr) {
+		t.Errorf("expected a *ParseError, got %v", err)
+	}
+}
+
+func TestScaledMoneyWithScale(t *testing.T) {
+	m, err := Money{M: 1234, C: EUR}.WithScale(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.String() != "12.34000 EUR" {
+		t.Errorf("expected 12.34000 EUR, got %s", m)
+	}
+	if _, err := (Money{M: 1234, C: EUR}).WithScale(1); !errors.Is(err, ErrMoneyInvalidScale) {
+		t.Errorf("expected ErrMoneyInvalidScale for a scale below the currency, got %v", err)
+	}
+	if _, err := (Money{M: 1234, C: EUR}).WithScale(MAXDEC + 1); !errors.Is(err, ErrMoneyInvalidScale) {
+		t.Errorf("expected ErrMoneyInvalidScale for a scale above MAXDEC, got %v", err)
+	}
+	if _, err := NewScaledMoney(KWD, big.NewInt(1), 2); !errors.Is(err, ErrMoneyInvalidScale) {
+		t.Errorf("expected ErrMoneyInvalidScale for KWD at scale 2, got %v", err)
+	}
+}
+
+func TestScaledMoneyQuantize(t *testing.T) {
+	tests := []struct {
+		amount   string
+		mode     RoundingMode
+		expected Money
+	}{
+		{"80.98803", RoundHalfUp, Money{M: 8099, C: EUR}},
+		{"80.98803", RoundTruncate, Money{M: 8098, C: EUR}},
+		{"-0.125", RoundHalfEven, Money{M: -12, C: EUR}},
+		{"-0.125", RoundHalfUp, Money{M: -13, C: EUR}},
+		{"0.00001", RoundCeiling, Money{M: 1, C: EUR}},
+		{"12.3", RoundHalfUp, Money{M: 1230, C: EUR}},
+	}
+	for _, test := range tests {
+		got, err := scaledMoney(t, EUR, test.amount).Quantize(test.mode)
+		if err != nil {
+			t.Errorf("%s %v: unexpected error: %v", test.amount, test.mode, err)
+		} else if got != test.expected {
+			t.Errorf("%s %v: expected %v, got %v", test.amount, test.mode, test.expected, got)
+		}
+	}
+
+	if _, err := scaledMoney(t, EUR, "92233720368547758.08").Quantize(RoundHalfUp); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("expected ErrMoneyOverflow, got %v", err)
+	}
+	if got := scaledMoney(t, EUR, "92233720368547758.075").QuantizeBig(RoundHalfUp); got.String() != "92233720368547758.08 EUR" {
+		t.Errorf("QuantizeBig: got %v", got)
+	}
+
+	m, err := scaledMoney(t, EUR, "1.23456").Rescale(3, RoundHalfUp)
+	if err != nil || m.String() != "1.235 EUR" {
+		t.Errorf("Rescale: expected 1.235 EUR, got %v, %v", m, err)
+	}
+}
+
+func TestScaledMoneyArithmetic(t *testing.T) {
+	price := scaledMoney(t, EUR, "1.789")
+	total, err := price.MulDecimalString("45.27")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.String() != "80.988 EUR" {
+		t.Errorf("expected 80.988 EUR, got %s", total)
+	}
+	if got := price.MulRatRounded(big.NewRat(1, 3), RoundFloor); got.String() != "0.596 EUR" {
+		t.Errorf("MulRatRounded: expected 0.596 EUR, got %s", got)
+	}
+	if _, err := price.MulDecimalString("x"); !errors.Is(err, ErrMoneyInvalidNumber) {
+		t.Errorf("expected ErrMoneyInvalidNumber, got %v", err)
+	}
+
+	// Accrue 5% p.a. of interest daily for 30 days at scale 8, and only
+	// round the total.
+	balance, _ := Money{M: 1000000, C: USD}.WithScale(8)
+	daily := big.NewRat(5, 100*365)
+	var interest ScaledMoney
+	for day := 0; day < 30; day++ {
+		interest = interest.Add(balance.MulRat(daily))
+	}
+	if interest.String() != "41.09589030 USD" {
+		t.Errorf("expected 41.09589030 USD of interest, got %s", interest)
+	}
+	if got, _ := interest.Quantize(RoundHalfEven); got != (Money{M: 4110, C: USD}) {
+		t.Errorf("expected 41.10 USD, got %v", got)
+	}
+
+	a := scaledMoney(t, USD, "0.000025")
+	b := scaledMoney(t, USD, "1.5")
+	if got := a.Add(b); got.String() != "1.500025 USD" || got.Scale != 6 {
+		t.Errorf("Add: expected 1.500025 USD at scale 6, got %s at scale %d", got, got.Scale)
+	}
+	if got := b.Sub(a); got.String() != "1.499975 USD" {
+		t.Errorf("Sub: expected 1.499975 USD, got %s", got)
+	}
+	if a.String() != "0.000025 USD" || b.String() != "1.50 USD" {
+		t.Errorf("operands must not be modified, got %s and %s", a, b)
+	}
+	if c, err := scaledMoney(t, USD, "1.50").Cmp(scaledMoney(t, USD, "1.5000")); err != nil || c != 0 {
+		t.Errorf("Cmp: expected equal amounts at different scales, got %d, %v", c, err)
+	}
+	if c, _ := a.Cmp(b); c != -1 {
+		t.Errorf("Cmp: expected -1, got %d", c)
+	}
+	if _, err := a.AddChecked(scaledMoney(t, EUR, "1")); !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected ErrMoneyCurrencyMismatch, got %v", err)
+	}
+	convert := MoneyMath{Policy: MismatchConvert, Rates: testRates{EUR: {USD: big.NewRat(11, 10)}}}
+	if got, err := convert.AddScaled(a, scaledMoney(t, EUR, "1")); err != nil || got.String() != "1.100025 USD" {
+		t.Errorf("AddScaled: expected 1.100025 USD, got %v, %v", got, err)
+	}
+	if got, err := convert.SubScaled(a, scaledMoney(t, EUR, "1")); err != nil || got.String() != "-1.099975 USD" {
+		t.Errorf("SubScaled: expected -1.099975 USD, got %v, %v", got, err)
+	}
+	if got := a.Neg().Abs(); got.String() != a.String() || a.Neg().Sign() != -1 || !(ScaledMoney{}).IsZero() {
+		t.Errorf("unexpected sign handling for %s", a)
+	}
+}
+
+func TestScaledMoneyFormat(t *testing.T) {
+	m := scaledMoney(t, EUR, "1234.789")
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{"de_DE", "1.234,789 €"},
+		{"en_US", "€1,234.789"},
+		{"xx_XX", "1234.789 EUR"},
+	}
+	for _, test := range tests {
+		if got := m.Format(test.locale); got != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.locale, test.expected, got)
+		}
+	}
+}
+
+func TestScaledMoneyEncoding(t *testing.T) {
+	m := scaledMoney(t, USD, "0.000025")
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"amount":"0.000025","currency":"USD"}`; string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+	var got ScaledMoney
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != m.String() || got.Scale != m.Scale {
+		t.Errorf("expected %v, got %v", m, got)
+	}
+	if err := json.Unmarshal([]byte(`{"M":25,"C":"USD"}`), &got); !errors.Is(err, ErrMoneyInvalidJSON) {
+		t.Errorf("expected ErrMoneyInvalidJSON, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"amount":"1","currency":"XXY"}`), &got); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+
+	text, _ := m.MarshalText()
+	if err := got.UnmarshalText(text); err != nil || got.String() != m.String() {
+		t.Errorf("expected %v, got %v, %v", m, got, err)
+	}
+	if text, _ := (ScaledMoney{}).MarshalText(); len(text) != 0 {
+		t.Errorf("expected empty text for the zero value, got %q", text)
+	}
+}
@@ -0,0 +1,489 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrUnsupportedLanguage is returned when spelling out an amount in a
+// language that isn't supported.
+var ErrUnsupportedLanguage = errors.New("i18n: unsupported language")
+
+// SpellOutOptions controls how Money.SpellOutWith spells out an amount.
+// The zero value spells out like Money.SpellOut.
+type SpellOutOptions struct {
+	// MinorUnitsInWords spells out the minor units with the name of the
+	// minor unit, e.g. "and fifty-six cents" rather than "and 56/100",
+	// and omits them if they are zero. Currencies without a minor unit name
+	// (see CurrencyUnits) always use the fraction.
+	MinorUnitsInWords bool
+}
+
+// SpellOut returns m in words for the given locale, as written on cheques
+// and in legal documents, e.g. "one thousand two hundred thirty-four
+// dollars and 56/100" for en_US. The minor units are written as a fraction
+// of the major unit, e.g. 56/100 for USD and 456/1000 for KWD, and are
+// left out for currencies without minor units, such as JPY.
+//
+// English, German, French, Spanish and Portuguese are supported. Other
+// languages return ErrUnsupportedLanguage.
+func (m Money) SpellOut(locale string) (string, error) {
+	return m.SpellOutWith(locale, SpellOutOptions{})
+}
+
+// SpellOutWith is like SpellOut, but uses the given options.
+func (m Money) SpellOutWith(locale string, opts SpellOutOptions) (string, error) {
+	l, found := Locales[locale]
+	if !found {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedLanguage, locale)
+	}
+	sp, found := spellers[l.Language]
+	if !found {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedLanguage, l.Language)
+	}
+
+	abs := uint64(m.Value())
+	if m.Sign() < 0 {
+		abs = -abs
+	}
+	dp := uint64(m.dp())
+	whole, frac := abs/dp, abs%dp
+
+	unit, hasUnit := CurrencyUnits[l.Language][m.C]
+	one := pluralOne(l.Language, whole, 0, false)
+	name := currencyName(l.Language, m.C, one)
+	if hasUnit {
+		name = unit.Major.Other
+		if one {
+			name = unit.Major.One
+		}
+	}
+	s := sp.number(whole, unit.Feminine) + " " + sp.unit(whole, name)
+	if m.Sign() < 0 {
+		s = sp.minus + " " + s
+	}
+
+	digits := m.digits()
+	switch {
+	case digits == 0:
+		return s, nil
+	case opts.MinorUnitsInWords && hasUnit && unit.Minor.Other != "":
+		if frac == 0 {
+			return s, nil
+		}
+		minor := unit.Minor.Other
+		if pluralOne(l.Language, frac, 0, false) {
+			minor = unit.Minor.One
+		}
+		return s + " " + sp.and + " " + sp.number(frac, unit.MinorFeminine) + " " + minor, nil
+	}
+	return fmt.Sprintf("%s %s %0*d/%d", s, sp.and, digits, frac, dp), nil
+}
+
+// speller spells out numbers in a specific language.
+type speller struct {
+	// number returns n in words, agreeing with a noun of the given gender.
+	number func(n uint64, feminine bool) string
+	// minus and and are the words for the negative sign and for joining
+	// the major and the minor units.
+	minus, and string
+	// of is the preposition between round millions and the unit, e.g.
+	// "un millón de dólares" in Spanish. elide reports whether it is elided
+	// before vowels, e.g. "un million d'euros" in French.
+	of    string
+	elide bool
+}
+
+var spellers = map[string]speller{
+	"en": {number: spellEnglish, minus: "minus", and: "and"},
+	"de": {number: spellGerman, minus: "minus", and: "und"},
+	"fr": {number: spellFrench, minus: "moins", and: "et", of: "de", elide: true},
+	"es": {number: spellSpanish, minus: "menos", and: "con", of: "de"},
+	"pt": {number: spellPortuguese, minus: "menos", and: "e", of: "de"},
+}
+
+// unit returns the unit name as it follows the number n.
+func (sp speller) unit(n uint64, name string) string {
+	if sp.of == "" || n < 1e6 || n%1e6 != 0 {
+		return name
+	}
+	if r, _ := utf8.DecodeRuneInString(name); sp.elide && strings.ContainsRune("aeiouyàâéèêîôû", r) {
+		return sp.of[:1] + "'" + name
+	}
+	return sp.of + " " + name
+}
+
+// pow1000 returns 1000^n.
+func pow1000(n int) uint64 {
+	p := uint64(1)
+	for i := 0; i < n; i++ {
+		p *= 1000
+	}
+	return p
+}
+
+var (
+	enOnes = [...]string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	enTens   = [...]string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	enScales = [...]string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+// spellEnglish spells out n in American English, e.g.
+// "one thousand two hundred thirty-four".
+func spellEnglish(n uint64, _ bool) string {
+	if n == 0 {
+		return enOnes[0]
+	}
+	var words []string
+	for i := len(enScales) - 1; i >= 0; i-- {
+		chunk := n / pow1000(i) % 1000
+		if chunk == 0 {
+			continue
+		}
+		words = append(words, enChunk(chunk))
+		if enScales[i] != "" {
+			words = append(words, enScales[i])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// enChunk spells out 0 < n < 1000.
+func enChunk(n uint64) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, enOnes[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n >= 20 && n%10 != 0:
+		words = append(words, enTens[n/10]+"-"+enOnes[n%10])
+	case n >= 20:
+		words = append(words, enTens[n/10])
+	case n > 0:
+		words = append(words, enOnes[n])
+	}
+	return strings.Join(words, " ")
+}
+
+var (
+	deOnes = [...]string{"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun", "zehn",
+		"elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn"}
+	deTens   = [...]string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+	deScales = [...][2]string{{"Million", "Millionen"}, {"Milliarde", "Milliarden"}, {"Billion", "Billionen"},
+		{"Billiarde", "Billiarden"}, {"Trillion", "Trillionen"}}
+)
+
+// spellGerman spells out n in German, e.g. "eintausendzweihundertvierunddreißig".
+// Millions and above are separate words, e.g. "zwei Millionen dreitausend".
+// As a unit follows, a trailing one is "ein", or "eine" if feminine, e.g.
+// "hundertein Euro" and "eine Million eine Krone".
+func spellGerman(n uint64, feminine bool) string {
+	if n == 0 {
+		return deOnes[0]
+	}
+	var words []string
+	for i := len(deScales); i >= 1; i-- {
+		chunk := n / pow1000(i+1) % 1000
+		switch {
+		case chunk == 1:
+			words = append(words, "eine "+deScales[i-1][0])
+		case chunk > 1:
+			words = append(words, deChunk(chunk)+" "+deScales[i-1][1])
+		}
+	}
+	if below := n % 1e6; below > 0 {
+		var s string
+		if t := below / 1000; t > 0 {
+			s = deChunk(t) + "tausend"
+		}
+		if r := below % 1000; r > 0 {
+			s += deChunk(r)
+			if r%100 == 1 && feminine {
+				s += "e"
+			}
+		}
+		words = append(words, s)
+	}
+	return strings.Join(words, " ")
+}
+
+// deChunk spells out 0 < n < 1000. A trailing one is "ein", as a
+// multiplier or a unit follows, e.g. "eintausend" and "hundertein Euro".
+func deChunk(n uint64) string {
+	var s string
+	if n >= 100 {
+		s = deUnit(n/100) + "hundert"
+		n %= 100
+	}
+	switch {
+	case n == 1:
+		s += "ein"
+	case n > 0 && n < 20:
+		s += deOnes[n]
+	case n >= 20 && n%10 != 0:
+		s += deUnit(n%10) + "und" + deTens[n/10]
+	case n >= 20:
+		s += deTens[n/10]
+	}
+	return s
+}
+
+// deUnit returns 0 < n < 10 as the first part of a compound.
+func deUnit(n uint64) string {
+	if n == 1 {
+		return "ein"
+	}
+	return deOnes[n]
+}
+
+var (
+	frOnes = [...]string{"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf", "dix",
+		"onze", "douze", "treize", "quatorze", "quinze", "seize", "dix-sept", "dix-huit", "dix-neuf"}
+	frTens   = [...]string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante", "soixante", "quatre-vingt", "quatre-vingt"}
+	frScales = [...][2]string{{"million", "millions"}, {"milliard", "milliards"}, {"billion", "billions"},
+		{"billiard", "billiards"}, {"trillion", "trillions"}}
+)
+
+// spellFrench spells out n in French, using the traditional spelling,
+// e.g. "mille deux cent trente-quatre" and "quatre-vingts".
+func spellFrench(n uint64, feminine bool) string {
+	if n == 0 {
+		return frOnes[0]
+	}
+	var words []string
+	for i := len(frScales); i >= 1; i-- {
+		chunk := n / pow1000(i+1) % 1000
+		switch {
+		case chunk == 1:
+			words = append(words, "un "+frScales[i-1][0])
+		case chunk > 1:
+			words = append(words, frChunk(chunk, true)+" "+frScales[i-1][1])
+		}
+	}
+	switch t := n / 1000 % 1000; {
+	case t == 1:
+		words = append(words, "mille")
+	case t > 1:
+		// Cent and vingt are invariable before mille, e.g. deux cent mille.
+		words = append(words, frChunk(t, false)+" mille")
+	}
+	if r := n % 1000; r > 0 {
+		s := frChunk(r, true)
+		if feminine && strings.HasSuffix(s, "un") {
+			s += "e"
+		}
+		words = append(words, s)
+	}
+	return strings.Join(words, " ")
+}
+
+// frChunk spells out 0 < n < 1000. If plural, a trailing cent or
+// quatre-vingt takes an s, e.g. "deux cents".
+func frChunk(n uint64, plural bool) string {
+	var words []string
+	h, r := n/100, n%100
+	switch {
+	case h == 1:
+		words = append(words, "cent")
+	case h > 1 && r == 0 && plural:
+		words = append(words, frOnes[h]+" cents")
+	case h > 1:
+		words = append(words, frOnes[h]+" cent")
+	}
+	if r > 0 {
+		words = append(words, frBelow100(r, plural))
+	}
+	return strings.Join(words, " ")
+}
+
+// frBelow100 spells out 0 < n < 100.
+func frBelow100(n uint64, plural bool) string {
+	if n < 20 {
+		return frOnes[n]
+	}
+	t, u := n/10, n%10
+	if t == 7 || t == 9 {
+		// Soixante-dix and quatre-vingt-dix count on from ten
+		u += 10
+	}
+	switch {
+	case u == 0 && t == 8 && plural:
+		return frTens[t] + "s"
+	case u == 0:
+		return frTens[t]
+	case (u == 1 || u == 11) && t < 8:
+		return frTens[t] + " et " + frOnes[u]
+	}
+	return frTens[t] + "-" + frOnes[u]
+}
+
+var (
+	esOnes = [...]string{"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve", "diez",
+		"once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+		"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis",
+		"veintisiete", "veintiocho", "veintinueve"}
+	esTens     = [...]string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+	esHundreds = [...]string{"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos",
+		"seiscientos", "setecientos", "ochocientos", "novecientos"}
+	esScales = [...][2]string{{"millón", "millones"}, {"billón", "billones"}, {"trillón", "trillones"}}
+)
+
+// spellSpanish spells out n in Spanish, using the long scale, e.g.
+// "mil doscientos treinta y cuatro" and "mil millones" for 10^9.
+func spellSpanish(n uint64, feminine bool) string {
+	if n == 0 {
+		return esOnes[0]
+	}
+	var words []string
+	for i := len(esScales); i >= 1; i-- {
+		group := n / pow1000(2*i) % 1e6
+		switch {
+		case group == 1:
+			words = append(words, "un "+esScales[i-1][0])
+		case group > 1:
+			words = append(words, esBelowMillion(group, false)+" "+esScales[i-1][1])
+		}
+	}
+	if below := n % 1e6; below > 0 {
+		words = append(words, esBelowMillion(below, feminine))
+	}
+	return strings.Join(words, " ")
+}
+
+// esBelowMillion spells out 0 < n < 10^6, as it precedes a noun.
+func esBelowMillion(n uint64, feminine bool) string {
+	var words []string
+	switch t := n / 1000; {
+	case t == 1:
+		words = append(words, "mil")
+	case t > 1:
+		words = append(words, esChunk(t, feminine)+" mil")
+	}
+	if r := n % 1000; r > 0 {
+		words = append(words, esChunk(r, feminine))
+	}
+	return strings.Join(words, " ")
+}
+
+// esChunk spells out 0 < n < 1000, as it precedes a noun: a trailing uno
+// becomes un or una, and the hundreds agree with feminine nouns.
+func esChunk(n uint64, feminine bool) string {
+	if n == 100 {
+		return "cien"
+	}
+	var words []string
+	h, r := n/100, n%100
+	if h > 0 {
+		s := esHundreds[h]
+		if feminine && h > 1 {
+			s = strings.TrimSuffix(s, "os") + "as"
+		}
+		words = append(words, s)
+	}
+	if r > 0 {
+		var s string
+		if r < 30 {
+			s = esOnes[r]
+		} else {
+			s = esTens[r/10]
+			if r%10 > 0 {
+				s += " y " + esOnes[r%10]
+			}
+		}
+		switch {
+		case strings.HasSuffix(s, "uno") && feminine:
+			s = strings.TrimSuffix(s, "o") + "a"
+		case s == "veintiuno":
+			s = "veintiún"
+		case strings.HasSuffix(s, "uno"):
+			s = strings.TrimSuffix(s, "o")
+		}
+		words = append(words, s)
+	}
+	return strings.Join(words, " ")
+}
+
+var (
+	ptOnes = [...]string{"zero", "um", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove", "dez",
+		"onze", "doze", "treze", "catorze", "quinze", "dezesseis", "dezessete", "dezoito", "dezenove"}
+	ptTens     = [...]string{"", "", "vinte", "trinta", "quarenta", "cinquenta", "sessenta", "setenta", "oitenta", "noventa"}
+	ptHundreds = [...]string{"", "cento", "duzentos", "trezentos", "quatrocentos", "quinhentos",
+		"seiscentos", "setecentos", "oitocentos", "novecentos"}
+	ptScales = [...][2]string{{"milhão", "milhões"}, {"bilhão", "bilhões"}, {"trilhão", "trilhões"},
+		{"quatrilhão", "quatrilhões"}, {"quintilhão", "quintilhões"}}
+)
+
+// spellPortuguese spells out n in Brazilian Portuguese, using the short
+// scale, e.g. "mil duzentos e trinta e quatro".
+func spellPortuguese(n uint64, feminine bool) string {
+	if n == 0 {
+		return ptOnes[0]
+	}
+	var groups []string
+	var last uint64
+	for i := len(ptScales) + 1; i >= 0; i-- {
+		chunk := n / pow1000(i) % 1000
+		if chunk == 0 {
+			continue
+		}
+		switch {
+		case i == 0:
+			groups = append(groups, ptChunk(chunk, feminine))
+		case i == 1 && chunk == 1:
+			groups = append(groups, "mil")
+		case i == 1:
+			groups = append(groups, ptChunk(chunk, feminine)+" mil")
+		case chunk == 1:
+			groups = append(groups, "um "+ptScales[i-2][0])
+		default:
+			groups = append(groups, ptChunk(chunk, false)+" "+ptScales[i-2][1])
+		}
+		last = chunk
+	}
+	s := strings.Join(groups[:len(groups)-1], " ")
+	switch {
+	case s == "":
+	case last < 100 || last%100 == 0:
+		// The last group is joined with "e" if it is below a hundred or
+		// a round number of hundreds, e.g. "mil e duzentos".
+		s += " e "
+	default:
+		s += " "
+	}
+	return s + groups[len(groups)-1]
+}
+
+// ptChunk spells out 0 < n < 1000. Ones, twos and hundreds agree with
+// feminine nouns.
+func ptChunk(n uint64, feminine bool) string {
+	if n == 100 {
+		return "cem"
+	}
+	var words []string
+	h, r := n/100, n%100
+	if h > 0 {
+		s := ptHundreds[h]
+		if feminine && h > 1 {
+			s = strings.TrimSuffix(s, "os") + "as"
+		}
+		words = append(words, s)
+	}
+	if r >= 20 {
+		words = append(words, ptTens[r/10])
+		r %= 10
+	}
+	if r > 0 {
+		s := ptOnes[r]
+		if feminine && r == 1 {
+			s = "uma"
+		} else if feminine && r == 2 {
+			s = "duas"
+		}
+		words = append(words, s)
+	}
+	return strings.Join(words, " e ")
+}
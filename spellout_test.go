@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMoneySpellOut(t *testing.T) {
+	tests := []struct {
+		m        Money
+		locale   string
+		expected string
+	}{
+		{MakeMoney(USD, 1234.56), "en_US", "one thousand two hundred thirty-four dollars and 56/100"},
+		{MakeMoney(USD, 1), "en_US", "one dollar and 00/100"},
+		{MakeMoney(USD, 0.99), "en_US", "zero dollars and 99/100"},
+		{MakeMoney(USD, -21.05), "en_US", "minus twenty-one dollars and 05/100"},
+		{MakeMoney(USD, 1000000), "en_US", "one million dollars and 00/100"},
+		{Money{M: -9223372036854775808, C: USD}, "en_US", "minus ninety-two quadrillion two hundred thirty-three trillion seven hundred twenty billion three hundred sixty-eight million five hundred forty-seven thousand seven hundred fifty-eight dollars and 08/100"},
+		{MakeMoney(JPY, 1234567), "en_US", "one million two hundred thirty-four thousand five hundred sixty-seven yen"},
+		{MakeMoney(KWD, 12.345), "en_US", "twelve dinars and 345/1000"},
+		{MakeMoney(PLN, 5), "en_US", "five Polish zlotys and 00/100"},
+		{MakeMoney(EUR, 1234.56), "de_DE", "eintausendzweihundertvierunddreißig Euro und 56/100"},
+		{MakeMoney(EUR, 1), "de_DE", "ein Euro und 00/100"},
+		{MakeMoney(EUR, 101001), "de_DE", "einhunderteintausendein Euro und 00/100"},
+		{MakeMoney(EUR, 101), "de_DE", "einhundertein Euro und 00/100"},
+		{MakeMoney(EUR, 1000001), "de_DE", "eine Million ein Euro und 00/100"},
+		{MakeMoney(DKK, 1000001), "de_DE", "eine Million eine Kronen und 00/100"},
+		{MakeMoney(DKK, 201), "de_DE", "zweihunderteine Kronen und 00/100"},
+		{MakeMoney(EUR, 2000000), "de_DE", "zwei Millionen Euro und 00/100"},
+		{MakeMoney(EUR, 1000021), "de_DE", "eine Million einundzwanzig Euro und 00/100"},
+		{MakeMoney(DKK, 1), "de_DE", "eine Krone und 00/100"},
+		{MakeMoney(EUR, 1234.56), "fr_FR", "mille deux cent trente-quatre euros et 56/100"},
+		{MakeMoney(EUR, 1), "fr_FR", "un euro et 00/100"},
+		{MakeMoney(EUR, 0.5), "fr_FR", "zéro euro et 50/100"},
+		{MakeMoney(EUR, 71), "fr_FR", "soixante et onze euros et 00/100"},
+		{MakeMoney(EUR, 80), "fr_FR", "quatre-vingts euros et 00/100"},
+		{MakeMoney(EUR, 81), "fr_FR", "quatre-vingt-un euros et 00/100"},
+		{MakeMoney(EUR, 99), "fr_FR", "quatre-vingt-dix-neuf euros et 00/100"},
+		{MakeMoney(EUR, 200), "fr_FR", "deux cents euros et 00/100"},
+		{MakeMoney(EUR, 200000), "fr_FR", "deux cent mille euros et 00/100"},
+		{MakeMoney(EUR, 1000000), "fr_FR", "un million d'euros et 00/100"},
+		{MakeMoney(USD, 3000000), "fr_FR", "trois millions de dollars et 00/100"},
+		{MakeMoney(GBP, 21), "fr_FR", "vingt et une livres et 00/100"},
+		{MakeMoney(EUR, 1234.56), "es_ES", "mil doscientos treinta y cuatro euros con 56/100"},
+		{MakeMoney(EUR, 100), "es_ES", "cien euros con 00/100"},
+		{MakeMoney(EUR, 21), "es_ES", "veintiún euros con 00/100"},
+		{MakeMoney(EUR, 31000), "es_ES", "treinta y un mil euros con 00/100"},
+		{MakeMoney(GBP, 201), "es_ES", "doscientas una libras con 00/100"},
+		{MakeMoney(USD, 1000000), "es_ES", "un millón de dólares con 00/100"},
+		{MakeMoney(USD, 2500000000), "es_ES", "dos mil quinientos millones de dólares con 00/100"},
+		{MakeMoney(BRL, 1234.56), "pt_BR", "mil duzentos e trinta e quatro reais e 56/100"},
+		{MakeMoney(BRL, 1), "pt_BR", "um real e 00/100"},
+		{MakeMoney(BRL, 1200), "pt_BR", "mil e duzentos reais e 00/100"},
+		{MakeMoney(BRL, 2000005), "pt_BR", "dois milhões e cinco reais e 00/100"},
+		{MakeMoney(BRL, 1000000), "pt_BR", "um milhão de reais e 00/100"},
+		{MakeMoney(GBP, 2), "pt_BR", "duas libras e 00/100"},
+	}
+	for _, test := range tests {
+		got, err := test.m.SpellOut(test.locale)
+		if err != nil {
+			t.Errorf("%v in %s: unexpected error: %v", test.m, test.locale, err)
+		}
+		if got != test.expected {
+			t.Errorf("%v in %s: expected %q, got %q", test.m, test.locale, test.expected, got)
+		}
+	}
+}
+
+func TestMoneySpellOutMinorUnitsInWords(t *testing.T) {
+	opts := SpellOutOptions{MinorUnitsInWords: true}
+	tests := []struct {
+		m        Money
+		locale   string
+		expected string
+	}{
+		{MakeMoney(USD, 1234.56), "en_US", "one thousand two hundred thirty-four dollars and fifty-six cents"},
+		{MakeMoney(USD, 1.01), "en_US", "one dollar and one cent"},
+		{MakeMoney(USD, 5), "en_US", "five dollars"},
+		{MakeMoney(GBP, 2.5), "en_GB", "two pounds and fifty pence"},
+		{MakeMoney(KWD, 1.001), "en_US", "one dinar and one fils"},
+		{MakeMoney(PLN, 5.5), "en_US", "five Polish zlotys and 50/100"},
+		{MakeMoney(EUR, 3.21), "de_DE", "drei Euro und einundzwanzig Cent"},
+		{MakeMoney(EUR, 3.21), "fr_FR", "trois euros et vingt et un centimes"},
+		{MakeMoney(EUR, 3.21), "es_ES", "tres euros con veintiún céntimos"},
+		{MakeMoney(BRL, 3.21), "pt_BR", "três reais e vinte e um centavos"},
+	}
+	for _, test := range tests {
+		got, err := test.m.SpellOutWith(test.locale, opts)
+		if err != nil {
+			t.Errorf("%v in %s: unexpected error: %v", test.m, test.locale, err)
+		}
+		if got != test.expected {
+			t.Errorf("%v in %s: expected %q, got %q", test.m, test.locale, test.expected, got)
+		}
+	}
+}
+
+func TestMoneySpellOutUnsupported(t *testing.T) {
+	for _, locale := range []string{"ja_JP", "xx_XX"} {
+		if _, err := MakeMoney(USD, 1).SpellOut(locale); !errors.Is(err, ErrUnsupportedLanguage) {
+			t.Errorf("%s: expected ErrUnsupportedLanguage, got %v", locale, err)
+		}
+	}
+}
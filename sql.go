@@ -0,0 +1,111 @@
+package i18n
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownCurrency is returned for currency codes that aren't in Currencies.
+var ErrUnknownCurrency = errors.New("i18n: unknown currency")
+
+// Value implements driver.Valuer. It stores the ISO code, e.g. USD,
+// and returns ErrUnknownCurrency for codes that aren't in Currencies.
+func (c CurrencyCode) Value() (driver.Value, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return string(c), nil
+}
+
+// Scan implements sql.Scanner. It accepts strings and byte slices holding
+// an ISO code that is in Currencies.
+func (c *CurrencyCode) Scan(src interface{}) error {
+	s, err := scanString(src, "CurrencyCode")
+	if err != nil {
+		return err
+	}
+	code := CurrencyCode(s)
+	if err := code.validate(); err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
+
+func (c CurrencyCode) validate() error {
+	if _, found := Currencies[c]; !found {
+		return fmt.Errorf("%w: %q", ErrUnknownCurrency, string(c))
+	}
+	return nil
+}
+
+// Scan implements sql.Scanner. It accepts strings and byte slices in the
+// canonical text form of Money.String, e.g. "1234.56 USD". NULL values
+// are rejected; use NullMoney for nullable columns.
+//
+// Money can't implement driver.Valuer, as Money.Value returns the amount
+// in minor units. To store a Money, pass m.DriverValue() or
+// NullMoney{Money: m, Valid: true}, which store m in the same text form.
+func (m *Money) Scan(src interface{}) error {
+	s, err := scanString(src, "Money")
+	if err != nil {
+		return err
+	}
+	v, err := parseMoneyString(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// DriverValue returns m as a driver.Value in the canonical text form of
+// Money.String, e.g. "1234.56 USD", like driver.Valuer would. It returns
+// ErrUnknownCurrency if the currency of m isn't in Currencies.
+func (m Money) DriverValue() (driver.Value, error) {
+	if err := m.C.validate(); err != nil {
+		return nil, err
+	}
+	return m.String(), nil
+}
+
+// NullMoney represents a Money that may be NULL, like sql.NullString.
+// It implements driver.Valuer and sql.Scanner using the canonical text form
+// of Money.String, e.g. "1234.56 USD".
+type NullMoney struct {
+	Money Money
+	// Valid is true if Money is not NULL.
+	Valid bool
+}
+
+// Value implements driver.Valuer using Money.DriverValue.
+func (n NullMoney) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Money.DriverValue()
+}
+
+// Scan implements sql.Scanner.
+func (n *NullMoney) Scan(src interface{}) error {
+	if src == nil {
+		n.Money, n.Valid = Money{}, false
+		return nil
+	}
+	if err := n.Money.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+func scanString(src interface{}, into string) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	}
+	return "", fmt.Errorf("i18n: cannot scan %T into %s", src, into)
+}
@@ -0,0 +1,129 @@
+package i18n
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+func TestCurrencyCodeSQL(t *testing.T) {
+	v, err := EUR.Value()
+	if err != nil || v != "EUR" {
+		t.Errorf("expected EUR, got %v, %v", v, err)
+	}
+	if _, err := CurrencyCode("XXY").Value(); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+
+	var c CurrencyCode
+	for _, src := range []interface{}{"USD", []byte("USD")} {
+		if err := c.Scan(src); err != nil || c != USD {
+			t.Errorf("%v: expected USD, got %v, %v", src, c, err)
+		}
+	}
+	for _, src := range []interface{}{"usd", "XXY", nil, 42} {
+		if err := c.Scan(src); err == nil {
+			t.Errorf("%v: expected error", src)
+		}
+	}
+}
+
+func TestMoneyScan(t *testing.T) {
+	tests := []struct {
+		src      interface{}
+		expected Money
+	}{
+		{"1234.56 USD", Money{M: 123456, C: USD}},
+		{[]byte("-0.05 EUR"), Money{M: -5, C: EUR}},
+		{"12.5 EUR", Money{M: 1250, C: EUR}},
+		{"1234 EUR", Money{M: 123400, C: EUR}},
+		{"123456 JPY", Money{M: 123456, C: JPY}},
+		{"1.234 KWD", Money{M: 1234, C: KWD}},
+		{"-92233720368547758.08 USD", Money{M: -9223372036854775808, C: USD}},
+	}
+	for _, test := range tests {
+		var m Money
+		if err := m.Scan(test.src); err != nil {
+			t.Errorf("%v: unexpected error: %v", test.src, err)
+		}
+		if m != test.expected {
+			t.Errorf("%v: expected %#v, got %#v", test.src, test.expected, m)
+		}
+	}
+
+	errs := []struct {
+		src interface{}
+		err error
+	}{
+		{"1234.56", nil},
+		{"1234.56 XXY", ErrUnknownCurrency},
+		{"12.345 USD", nil},
+		{"12. USD", nil},
+		{"1,234.56 USD", ErrMoneyInvalidNumber},
+		{"+5.00 USD", ErrMoneyInvalidNumber},
+		{"92233720368547758.08 USD", ErrMoneyOverflow},
+		{nil, nil},
+		{123456, nil},
+	}
+	for _, test := range errs {
+		var m Money
+		err := m.Scan(test.src)
+		if err == nil {
+			t.Errorf("%v: expected error", test.src)
+		}
+		if test.err != nil && !errors.Is(err, test.err) {
+			t.Errorf("%v: expected %v, got %v", test.src, test.err, err)
+		}
+	}
+}
+
+func TestMoneyDriverValue(t *testing.T) {
+	v, err := MakeMoney(USD, 1234.56).DriverValue()
+	if err != nil || v != "1234.56 USD" {
+		t.Errorf("expected 1234.56 USD, got %v, %v", v, err)
+	}
+	var m Money
+	if err := m.Scan(v); err != nil || m != MakeMoney(USD, 1234.56) {
+		t.Errorf("%v: expected 1234.56 USD, got %v, %v", v, m, err)
+	}
+	for _, m := range []Money{{}, {M: 5, C: "XXY"}} {
+		if _, err := m.DriverValue(); !errors.Is(err, ErrUnknownCurrency) {
+			t.Errorf("%#v: expected ErrUnknownCurrency, got %v", m, err)
+		}
+	}
+}
+
+func TestNullMoney(t *testing.T) {
+	tests := []struct {
+		n        NullMoney
+		expected driver.Value
+	}{
+		{NullMoney{}, nil},
+		{NullMoney{Money: MakeMoney(USD, 1234.56), Valid: true}, "1234.56 USD"},
+		{NullMoney{Money: MakeMoney(JPY, -5), Valid: true}, "-5 JPY"},
+		{NullMoney{Money: MakeMoney(KWD, 0.001), Valid: true}, "0.001 KWD"},
+	}
+	for _, test := range tests {
+		v, err := test.n.Value()
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", test.n, err)
+		}
+		if v != test.expected {
+			t.Errorf("%v: expected %v, got %v", test.n, test.expected, v)
+		}
+		var n NullMoney
+		if err := n.Scan(v); err != nil {
+			t.Errorf("%v: unexpected error: %v", v, err)
+		}
+		if n != test.n {
+			t.Errorf("%v: expected %v, got %v", v, test.n, n)
+		}
+	}
+	if _, err := (NullMoney{Valid: true}).Value(); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+	n := NullMoney{Money: MakeMoney(USD, 1), Valid: true}
+	if err := n.Scan("bogus"); err == nil {
+		t.Errorf("expected error")
+	}
+}
@@ -0,0 +1,49 @@
+package i18n
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// FormatColumn formats values for the given locale and options like
+// Money.FormatWith, and pads them with spaces to the same width so that
+// they line up on their decimal separators in a column of a plain-text
+// table. Amounts without decimals line up on the end of their number.
+// For example, for en_US with Accounting:
+//
+//	|$1,234.56 |
+//	|   $12.00 |
+//	| ($950.10)|
+//	|¥1,235    |
+//
+// Widths are counted in characters, which assumes a monospaced font.
+func FormatColumn(locale string, opts FormatOptions, values ...Money) []string {
+	l, found := Locales[locale]
+	cells := make([]string, len(values))
+	points := make([]int, len(values))
+	var left, right int
+	for i, v := range values {
+		var point int
+		if found {
+			n, digits := v.formatValue(opts)
+			cells[i], point = formatMoneyAligned(l, v.C, n, digits, opts)
+		} else {
+			cells[i] = v.String()
+			if point = strings.IndexByte(cells[i], '.'); point < 0 {
+				point = strings.IndexByte(cells[i], ' ')
+			}
+		}
+		points[i] = utf8.RuneCountInString(cells[i][:point])
+		if points[i] > left {
+			left = points[i]
+		}
+		if n := utf8.RuneCountInString(cells[i]) - points[i]; n > right {
+			right = n
+		}
+	}
+	for i, cell := range cells {
+		pad := right - (utf8.RuneCountInString(cell) - points[i])
+		cells[i] = strings.Repeat(" ", left-points[i]) + cell + strings.Repeat(" ", pad)
+	}
+	return cells
+}
@@ -0,0 +1,42 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFormatColumn(t *testing.T) {
+	tests := []struct {
+		locale   string
+		opts     FormatOptions
+		values   []Money
+		expected []string
+	}{
+		{
+			"en_US", FormatOptions{Accounting: true},
+			[]Money{{123456, USD}, {1200, USD}, {-95010, USD}, {1235, JPY}},
+			[]string{"$1,234.56 ", "   $12.00 ", " ($950.10)", "¥1,235    "},
+		},
+		{
+			"de_DE", FormatOptions{Accounting: true},
+			[]Money{{123456, EUR}, {-1200, EUR}, {1235, JPY}},
+			[]string{"1.234,56 € ", "  (12,00 €)", "1.235 ¥    "},
+		},
+		{
+			"en_US", FormatOptions{Display: DisplayCode, Compact: true},
+			[]Money{{123456789, USD}, {-5000000, USD}},
+			[]string{"  USD\u00a01.2M", "(USD\u00a050K) "},
+		},
+		{
+			"xx_XX", FormatOptions{},
+			[]Money{{123456, EUR}, {-1200, EUR}, {1235, JPY}},
+			[]string{"1234.56 EUR", " -12.00 EUR", "1235 JPY   "},
+		},
+		{"en_US", FormatOptions{}, nil, []string{}},
+	}
+	for _, test := range tests {
+		if got := FormatColumn(test.locale, test.opts, test.values...); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%s %+v %v: expected %q, got %q", test.locale, test.opts, test.values, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// ErrMoneyInvalidTaxRate is returned for tax rates that are missing or
+// negative.
+var ErrMoneyInvalidTaxRate = errors.New("i18n: invalid tax rate")
+
+// ParseTaxRate parses a tax rate given as a decimal fraction, e.g. "0.19",
+// or as a percentage, e.g. "19%" or "8.25%". The result is exact, unlike a
+// float64.
+func ParseTaxRate(s string) (*big.Rat, error) {
+	number, percent := strings.TrimSpace(s), false
+	if strings.HasSuffix(number, "%") {
+		number, percent = strings.TrimSpace(strings.TrimSuffix(number, "%")), true
+	}
+	r, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrMoneyInvalidNumber, s)
+	}
+	if percent {
+		r.Quo(r, big.NewRat(100, 1))
+	}
+	if r.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %q", ErrMoneyInvalidTaxRate, s)
+	}
+	return r, nil
+}
+
+func checkTaxRate(rate *big.Rat) error {
+	if rate == nil || rate.Sign() < 0 {
+		return ErrMoneyInvalidTaxRate
+	}
+	return nil
+}
+
+// AddTax returns the gross amount of the net amount m, i.e. m plus the tax
+// at the given rate, e.g. 19/100 for 19% VAT. The tax is rounded to the
+// minor unit of the currency with RoundHalfCeiling.
+// It panics if rate is nil or negative, or if the result overflows.
+func (m Money) AddTax(rate *big.Rat) Money {
+	gross, err := m.AddTaxRounded(rate, RoundHalfCeiling)
+	if err != nil {
+		panic(err)
+	}
+	return gross
+}
+
+// AddTaxRounded is like AddTax, but rounds the tax with the given mode and
+// returns an error instead of panicking.
+func (m Money) AddTaxRounded(rate *big.Rat, mode RoundingMode) (Money, error) {
+	if err := checkTaxRate(rate); err != nil {
+		return Money{}, err
+	}
+	a, err := taxExclusive(big.NewInt(m.M), rate, mode).money(m.C)
+	return a.Gross, err
+}
+
+// ExtractTax splits the gross amount m into its net amount and the tax it
+// contains at the given rate, e.g. 19/100 for 19% VAT. The tax is rounded
+// to the minor unit of the currency with RoundHalfCeiling, and net plus
+// tax is always m.
+// It panics if rate is nil or negative.
+func (m Money) ExtractTax(rate *big.Rat) (net, tax Money) {
+	net, tax, err := m.ExtractTaxRounded(rate, RoundHalfCeiling)
+	if err != nil {
+		panic(err)
+	}
+	return net, tax
+}
+
+// ExtractTaxRounded is like ExtractTax, but rounds the tax with the given
+// mode and returns an error instead of panicking.
+func (m Money) ExtractTaxRounded(rate *big.Rat, mode RoundingMode) (net, tax Money, err error) {
+	if err := checkTaxRate(rate); err != nil {
+		return Money{}, Money{}, err
+	}
+	a, err := taxInclusive(big.NewInt(m.M), rate, mode).money(m.C)
+	return a.Net, a.Tax, err
+}
+
+// TaxRounding specifies where CalculateTax rounds the tax of an invoice.
+type TaxRounding int
+
+const (
+	// RoundPerLine rounds the tax of each line, and adds up the rounded
+	// taxes.
+	RoundPerLine TaxRounding = iota
+	// RoundPerInvoice adds up the lines of each rate, and rounds the tax
+	// of the sum once. The tax of a rate is distributed to its lines, so
+	// that the lines still add up to the totals.
+	RoundPerInvoice
+)
+
+// TaxOptions are the options of CalculateTax.
+type TaxOptions struct {
+	// Inclusive specifies that the amounts of the lines are gross amounts
+	// that include the tax, e.g. consumer prices. Otherwise they are net
+	// amounts that the tax is added to.
+	Inclusive bool
+	// Rounding specifies where the tax is rounded.
+	Rounding TaxRounding
+	// Mode is the rounding mode of the tax.
+	Mode RoundingMode
+}
+
+// TaxLine is a line item of an invoice.
+type TaxLine struct {
+	// Amount is the price of the line, net or gross depending on
+	// TaxOptions.Inclusive. It may be negative, e.g. for a discount.
+	Amount Money
+	// Rate is the tax rate of the line, e.g. 19/100 for 19% VAT.
+	Rate *big.Rat
+}
+
+// TaxAmounts are the net amount, the tax and the gross amount of a line or
+// a total. Net plus Tax is always Gross.
+type TaxAmounts struct {
+	Net   Money
+	Tax   Money
+	Gross Money
+}
+
+// TaxRateAmounts are the amounts of all lines with the same rate.
+type TaxRateAmounts struct {
+	Rate *big.Rat
+	TaxAmounts
+}
+
+// TaxBreakdown is the result of CalculateTax.
+type TaxBreakdown struct {
+	// Lines has the amounts of each line, in the order of the lines.
+	Lines []TaxAmounts
+	// Rates has the amounts of each rate, sorted by rate.
+	Rates []TaxRateAmounts
+	// Total has the amounts of the whole invoice.
+	Total TaxAmounts
+}
+
+// CalculateTax calculates the tax of the lines of an invoice, e.g. for
+// items with 19% and 7% VAT. The amounts of the lines, of the rates and of
+// the total all satisfy Net + Tax == Gross exactly, and the lines add up
+// to the rates and the total.
+//
+// All lines must be in the same currency; an empty currency is compatible
+// with any other currency. CalculateTax returns a *CurrencyMismatchError
+// otherwise, ErrMoneyInvalidTaxRate for invalid rates, and
+// ErrMoneyOverflow if an amount doesn't fit into a Money.
+func CalculateTax(lines []TaxLine, opts TaxOptions) (TaxBreakdown, error) {
+	var c CurrencyCode
+	for _, line := range lines {
+		if err := checkTaxRate(line.Rate); err != nil {
+			return TaxBreakdown{}, err
+		}
+		if c != "" && line.Amount.C != "" && line.Amount.C != c {
+			return TaxBreakdown{}, &CurrencyMismatchError{A: c, B: line.Amount.C}
+		}
+		if c == "" {
+			c = line.Amount.C
+		}
+	}
+
+	calculate := taxExclusive
+	if opts.Inclusive {
+		calculate = taxInclusive
+	}
+
+	// Group the lines by rate. With RoundPerInvoice, the tax of a line is
+	// the difference of the rounded taxes of the running sums of its rate
+	// before and after the line, so that the taxes of the lines add up to
+	// the rounded tax of the sum.
+	type group struct {
+		rate    *big.Rat
+		sum     *big.Int
+		tax     *big.Int
+		amounts bigTaxAmounts
+	}
+	groups := make(map[string]*group)
+	var order []*group
+	lineAmounts := make([]bigTaxAmounts, len(lines))
+	for i, line := range lines {
+		key := line.Rate.RatString()
+		g, found := groups[key]
+		if !found {
+			g = &group{rate: line.Rate, sum: new(big.Int), tax: new(big.Int), amounts: newBigTaxAmounts()}
+			groups[key] = g
+			order = append(order, g)
+		}
+		amount := big.NewInt(line.Amount.M)
+		a := calculate(amount, line.Rate, opts.Mode)
+		if opts.Rounding == RoundPerInvoice {
+			g.sum.Add(g.sum, amount)
+			tax := calculate(g.sum, line.Rate, opts.Mode).tax
+			a = newBigTaxAmounts()
+			a.tax.Sub(tax, g.tax)
+			g.tax = tax
+			if opts.Inclusive {
+				a.gross.Set(amount)
+				a.net.Sub(amount, a.tax)
+			} else {
+				a.net.Set(amount)
+				a.gross.Add(amount, a.tax)
+			}
+		}
+		lineAmounts[i] = a
+		g.amounts.add(a)
+	}
+	sort.SliceStable(order, func(i, j int) bool { return order[i].rate.Cmp(order[j].rate) < 0 })
+
+	var result TaxBreakdown
+	total := newBigTaxAmounts()
+	for _, a := range lineAmounts {
+		v, err := a.money(c)
+		if err != nil {
+			return TaxBreakdown{}, err
+		}
+		result.Lines = append(result.Lines, v)
+	}
+	for _, g := range order {
+		v, err := g.amounts.money(c)
+		if err != nil {
+			return TaxBreakdown{}, err
+		}
+		result.Rates = append(result.Rates, TaxRateAmounts{Rate: new(big.Rat).Set(g.rate), TaxAmounts: v})
+		total.add(g.amounts)
+	}
+	v, err := total.money(c)
+	if err != nil {
+		return TaxBreakdown{}, err
+	}
+	result.Total = v
+	return result, nil
+}
+
+// bigTaxAmounts are TaxAmounts in minor units of arbitrary size.
+type bigTaxAmounts struct {
+	net, tax, gross *big.Int
+}
+
+func newBigTaxAmounts() bigTaxAmounts {
+	return bigTaxAmounts{net: new(big.Int), tax: new(big.Int), gross: new(big.Int)}
+}
+
+func (a bigTaxAmounts) add(b bigTaxAmounts) {
+	a.net.Add(a.net, b.net)
+	a.tax.Add(a.tax, b.tax)
+	a.gross.Add(a.gross, b.gross)
+}
+
+// money returns a in currency c, or ErrMoneyOverflow if an amount doesn't
+// fit into a Money.
+func (a bigTaxAmounts) money(c CurrencyCode) (TaxAmounts, error) {
+	if !a.net.IsInt64() || !a.tax.IsInt64() || !a.gross.IsInt64() {
+		return TaxAmounts{}, ErrMoneyOverflow
+	}
+	return TaxAmounts{
+		Net:   Money{M: a.net.Int64(), C: c},
+		Tax:   Money{M: a.tax.Int64(), C: c},
+		Gross: Money{M: a.gross.Int64(), C: c},
+	}, nil
+}
+
+// taxExclusive returns the amounts of the net amount net at rate, with the
+// tax rounded with mode.
+func taxExclusive(net *big.Int, rate *big.Rat, mode RoundingMode) bigTaxAmounts {
+	r := new(big.Rat).SetInt(net)
+	r.Mul(r, rate)
+	a := bigTaxAmounts{net: new(big.Int).Set(net), tax: mode.round(r.Num(), r.Denom())}
+	a.gross = new(big.Int).Add(a.net, a.tax)
+	return a
+}
+
+// taxInclusive returns the amounts of the gross amount gross at rate, with
+// the tax rounded with mode.
+func taxInclusive(gross *big.Int, rate *big.Rat, mode RoundingMode) bigTaxAmounts {
+	r := new(big.Rat).SetInt(gross)
+	r.Mul(r, rate)
+	r.Quo(r, new(big.Rat).Add(rate, big.NewRat(1, 1)))
+	a := bigTaxAmounts{gross: new(big.Int).Set(gross), tax: mode.round(r.Num(), r.Denom())}
+	a.net = new(big.Int).Sub(a.gross, a.tax)
+	return a
+}
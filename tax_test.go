@@ -0,0 +1,184 @@
+package i18n
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestParseTaxRate(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected *big.Rat
+	}{
+		{"19%", big.NewRat(19, 100)},
+		{"8.25%", big.NewRat(825, 10000)},
+		{" 7 % ", big.NewRat(7, 100)},
+		{"0.19", big.NewRat(19, 100)},
+		{"0", new(big.Rat)},
+	}
+	for _, test := range tests {
+		got, err := ParseTaxRate(test.s)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.s, err)
+		} else if got.Cmp(test.expected) != 0 {
+			t.Errorf("%q: expected %v, got %v", test.s, test.expected, got)
+		}
+	}
+	if _, err := ParseTaxRate("-5%"); !errors.Is(err, ErrMoneyInvalidTaxRate) {
+		t.Errorf("expected ErrMoneyInvalidTaxRate, got %v", err)
+	}
+	if _, err := ParseTaxRate("19 percent"); !errors.Is(err, ErrMoneyInvalidNumber) {
+		t.Errorf("expected ErrMoneyInvalidNumber, got %v", err)
+	}
+}
+
+func TestAddTax(t *testing.T) {
+	vat := big.NewRat(19, 100)
+	tests := []struct {
+		net      Money
+		mode     RoundingMode
+		expected Money
+	}{
+		{Money{M: 1000, C: EUR}, RoundHalfCeiling, Money{M: 1190, C: EUR}},
+		{Money{M: 5, C: EUR}, RoundHalfCeiling, Money{M: 6, C: EUR}},
+		{Money{M: 50, C: EUR}, RoundHalfCeiling, Money{M: 60, C: EUR}},
+		{Money{M: 50, C: EUR}, RoundTruncate, Money{M: 59, C: EUR}},
+		{Money{M: -50, C: EUR}, RoundHalfUp, Money{M: -60, C: EUR}},
+		{Money{M: 1000, C: JPY}, RoundHalfCeiling, Money{M: 1190, C: JPY}},
+	}
+	for _, test := range tests {
+		got, err := test.net.AddTaxRounded(vat, test.mode)
+		if err != nil {
+			t.Errorf("%v %v: unexpected error: %v", test.net, test.mode, err)
+		} else if got != test.expected {
+			t.Errorf("%v %v: expected %v, got %v", test.net, test.mode, test.expected, got)
+		}
+	}
+	if got := (Money{M: 1000, C: EUR}).AddTax(big.NewRat(7, 100)); got != (Money{M: 1070, C: EUR}) {
+		t.Errorf("expected 10.70 EUR, got %v", got)
+	}
+	if _, err := (Money{M: math.MaxInt64, C: EUR}).AddTaxRounded(vat, RoundHalfCeiling); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("expected ErrMoneyOverflow, got %v", err)
+	}
+	if _, err := (Money{M: 1, C: EUR}).AddTaxRounded(nil, RoundHalfCeiling); !errors.Is(err, ErrMoneyInvalidTaxRate) {
+		t.Errorf("expected ErrMoneyInvalidTaxRate, got %v", err)
+	}
+}
+
+func TestExtractTax(t *testing.T) {
+	tests := []struct {
+		gross    Money
+		rate     *big.Rat
+		net, tax int64
+	}{
+		{Money{M: 1190, C: EUR}, big.NewRat(19, 100), 1000, 190},
+		{Money{M: 100, C: EUR}, big.NewRat(7, 100), 93, 7},
+		{Money{M: 999, C: EUR}, big.NewRat(19, 100), 839, 160},
+		{Money{M: -1190, C: EUR}, big.NewRat(19, 100), -1000, -190},
+		{Money{M: 500, C: EUR}, new(big.Rat), 500, 0},
+	}
+	for _, test := range tests {
+		net, tax := test.gross.ExtractTax(test.rate)
+		if net.M != test.net || tax.M != test.tax || net.C != test.gross.C || tax.C != test.gross.C {
+			t.Errorf("%v at %v: expected %d + %d, got %v + %v", test.gross, test.rate, test.net, test.tax, net, tax)
+		}
+		if net.Add(tax) != test.gross {
+			t.Errorf("%v at %v: net and tax don't add up", test.gross, test.rate)
+		}
+	}
+}
+
+func TestCalculateTax(t *testing.T) {
+	vat, reduced := big.NewRat(19, 100), big.NewRat(7, 100)
+	lines := []TaxLine{
+		{Money{M: 3, C: EUR}, vat},
+		{Money{M: 3, C: EUR}, vat},
+		{Money{M: 3, C: EUR}, vat},
+		{Money{M: 1999, C: EUR}, reduced},
+		{Money{M: -500, C: EUR}, reduced},
+	}
+	tests := []struct {
+		opts  TaxOptions
+		taxes []int64
+		rates [][3]int64
+		total [3]int64
+	}{
+		{
+			TaxOptions{Rounding: RoundPerLine},
+			[]int64{1, 1, 1, 140, -35},
+			[][3]int64{{1499, 105, 1604}, {9, 3, 12}},
+			[3]int64{1508, 108, 1616},
+		},
+		{
+			TaxOptions{Rounding: RoundPerInvoice},
+			[]int64{1, 0, 1, 140, -35},
+			[][3]int64{{1499, 105, 1604}, {9, 2, 11}},
+			[3]int64{1508, 107, 1615},
+		},
+		{
+			TaxOptions{Inclusive: true, Rounding: RoundPerLine},
+			[]int64{0, 0, 0, 131, -33},
+			[][3]int64{{1401, 98, 1499}, {9, 0, 9}},
+			[3]int64{1410, 98, 1508},
+		},
+		{
+			TaxOptions{Inclusive: true, Rounding: RoundPerInvoice},
+			[]int64{0, 1, 0, 131, -33},
+			[][3]int64{{1401, 98, 1499}, {8, 1, 9}},
+			[3]int64{1409, 99, 1508},
+		},
+	}
+	for _, test := range tests {
+		got, err := CalculateTax(lines, test.opts)
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", test.opts, err)
+			continue
+		}
+		var taxes []int64
+		for i, line := range got.Lines {
+			taxes = append(taxes, line.Tax.M)
+			if line.Net.Add(line.Tax) != line.Gross {
+				t.Errorf("%+v: line %d doesn't add up: %+v", test.opts, i, line)
+			}
+			if amount := lines[i].Amount; test.opts.Inclusive && line.Gross != amount || !test.opts.Inclusive && line.Net != amount {
+				t.Errorf("%+v: line %d: expected amount %v, got %+v", test.opts, i, amount, line)
+			}
+		}
+		if !reflect.DeepEqual(taxes, test.taxes) {
+			t.Errorf("%+v: expected line taxes %v, got %v", test.opts, test.taxes, taxes)
+		}
+		var rates [][3]int64
+		for _, r := range got.Rates {
+			rates = append(rates, [3]int64{r.Net.M, r.Tax.M, r.Gross.M})
+		}
+		if !reflect.DeepEqual(rates, test.rates) {
+			t.Errorf("%+v: expected rates %v, got %v", test.opts, test.rates, rates)
+		}
+		if got.Rates[0].Rate.Cmp(reduced) != 0 || got.Rates[1].Rate.Cmp(vat) != 0 {
+			t.Errorf("%+v: expected rates sorted, got %v and %v", test.opts, got.Rates[0].Rate, got.Rates[1].Rate)
+		}
+		if total := [3]int64{got.Total.Net.M, got.Total.Tax.M, got.Total.Gross.M}; total != test.total || got.Total.Gross.C != EUR {
+			t.Errorf("%+v: expected total %v, got %+v", test.opts, test.total, got.Total)
+		}
+	}
+}
+
+func TestCalculateTaxErrors(t *testing.T) {
+	vat := big.NewRat(19, 100)
+	if _, err := CalculateTax([]TaxLine{{Money{M: 1, C: EUR}, vat}, {Money{M: 1, C: USD}, vat}}, TaxOptions{}); !errors.Is(err, ErrMoneyCurrencyMismatch) {
+		t.Errorf("expected ErrMoneyCurrencyMismatch, got %v", err)
+	}
+	if _, err := CalculateTax([]TaxLine{{Money{M: 1, C: EUR}, big.NewRat(-1, 100)}}, TaxOptions{}); !errors.Is(err, ErrMoneyInvalidTaxRate) {
+		t.Errorf("expected ErrMoneyInvalidTaxRate, got %v", err)
+	}
+	if _, err := CalculateTax([]TaxLine{{Money{M: math.MaxInt64, C: EUR}, vat}}, TaxOptions{}); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("expected ErrMoneyOverflow, got %v", err)
+	}
+	got, err := CalculateTax(nil, TaxOptions{})
+	if err != nil || len(got.Lines) != 0 || got.Total != (TaxAmounts{}) {
+		t.Errorf("expected an empty breakdown, got %+v, %v", got, err)
+	}
+}